@@ -0,0 +1,318 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// ParamsOption defines a functional option for NewParams that mutates the
+// Params being built.  Options are applied in the order they are provided,
+// which allows later options to override earlier ones.
+type ParamsOption func(*Params)
+
+// NewParams returns a new Params for the provided name and network,
+// assembled from the provided options.  It is intended to reduce the amount
+// of copy-paste required to define a new network by composing the pieces
+// that commonly vary between networks (subsidy, stake, address magics, HD
+// key IDs, deployments, treasury) instead of requiring a single giant struct
+// literal.
+//
+// The returned Params is not registered with the package; callers that want
+// it recognized by ParamsByNet/ParamsByName must pass it to Register or
+// MustRegister.
+func NewParams(name string, net wire.CurrencyNet, opts ...ParamsOption) *Params {
+	params := &Params{
+		Name: name,
+		Net:  net,
+	}
+	for _, opt := range opts {
+		opt(params)
+	}
+	return params
+}
+
+// WithSubsidyParams sets the block subsidy parameters on a Params being
+// built by NewParams.
+func WithSubsidyParams(baseSubsidy, mulSubsidy, divSubsidy int64, reductionInterval int64, workProportion, stakeProportion, taxProportion uint16) ParamsOption {
+	return func(p *Params) {
+		p.BaseSubsidy = baseSubsidy
+		p.MulSubsidy = mulSubsidy
+		p.DivSubsidy = divSubsidy
+		p.SubsidyReductionInterval = reductionInterval
+		p.WorkRewardProportion = workProportion
+		p.StakeRewardProportion = stakeProportion
+		p.BlockTaxProportion = taxProportion
+	}
+}
+
+// WithStakeParams sets the proof-of-stake parameters on a Params being
+// built by NewParams.  stakeValidationHeight is taken as an explicit
+// parameter rather than derived, since networks are free to choose it
+// independently of CoinbaseMaturity and TicketPoolSize (e.g. MainNet's is
+// fixed by DCP0001 rather than following RegNet/SimNet's convention).
+func WithStakeParams(ticketPoolSize, ticketsPerBlock, ticketMaturity, ticketExpiry, coinbaseMaturity uint16, sstxChangeMaturity, stakeValidationHeight int64) ParamsOption {
+	return func(p *Params) {
+		p.TicketPoolSize = ticketPoolSize
+		p.TicketsPerBlock = ticketsPerBlock
+		p.TicketMaturity = ticketMaturity
+		p.TicketExpiry = uint32(ticketExpiry)
+		p.CoinbaseMaturity = coinbaseMaturity
+		p.SStxChangeMaturity = sstxChangeMaturity
+		p.MaxFreshStakePerBlock = uint32(ticketsPerBlock) * 4
+		p.StakeEnabledHeight = int64(coinbaseMaturity) + int64(ticketMaturity)
+		p.StakeValidationHeight = stakeValidationHeight
+	}
+}
+
+// WithAddressMagics sets the address encoding magic bytes on a Params being
+// built by NewParams.
+func WithAddressMagics(prefix string, pubKeyAddrID, pubKeyHashAddrID, pkhEdwardsAddrID, pkhSchnorrAddrID, scriptHashAddrID, privateKeyID [2]byte) ParamsOption {
+	return func(p *Params) {
+		p.NetworkAddressPrefix = prefix
+		p.PubKeyAddrID = pubKeyAddrID
+		p.PubKeyHashAddrID = pubKeyHashAddrID
+		p.PKHEdwardsAddrID = pkhEdwardsAddrID
+		p.PKHSchnorrAddrID = pkhSchnorrAddrID
+		p.ScriptHashAddrID = scriptHashAddrID
+		p.PrivateKeyID = privateKeyID
+	}
+}
+
+// WithHDKeyIDs sets the BIP32 hierarchical deterministic extended key magics
+// on a Params being built by NewParams.
+func WithHDKeyIDs(hdPrivateKeyID, hdPublicKeyID [4]byte, slip0044CoinType, legacyCoinType uint32) ParamsOption {
+	return func(p *Params) {
+		p.HDPrivateKeyID = hdPrivateKeyID
+		p.HDPublicKeyID = hdPublicKeyID
+		p.SLIP0044CoinType = slip0044CoinType
+		p.LegacyCoinType = legacyCoinType
+	}
+}
+
+// WithDeployments sets the consensus rule change deployments on a Params
+// being built by NewParams.
+func WithDeployments(deployments map[uint32][]ConsensusDeployment) ParamsOption {
+	return func(p *Params) {
+		p.Deployments = deployments
+	}
+}
+
+// deploymentAt wraps vote in a single-element ConsensusDeployment slice
+// activating over [startTime, expireTime), the form every entry in a
+// Params' Deployments map takes.  It exists so the vote descriptions
+// themselves, which are identical across networks, can be shared instead of
+// being pasted into each network's deployment map with only the times
+// varying.
+func deploymentAt(vote Vote, startTime, expireTime uint64) []ConsensusDeployment {
+	return []ConsensusDeployment{{
+		Vote:       vote,
+		StartTime:  startTime,
+		ExpireTime: expireTime,
+	}}
+}
+
+// voteMaxBlockSize returns the Vote describing VoteIDMaxBlockSize, the
+// agenda to change the maximum allowed block size from 1MiB to 1.25MB.
+func voteMaxBlockSize() Vote {
+	return Vote{
+		Id:          VoteIDMaxBlockSize,
+		Description: "Change maximum allowed block size from 1MiB to 1.25MB",
+		Mask:        0x0006, // Bits 1 and 2
+		Choices: []Choice{{
+			Id:          "abstain",
+			Description: "abstain voting for change",
+			Bits:        0x0000,
+			IsAbstain:   true,
+			IsNo:        false,
+		}, {
+			Id:          "no",
+			Description: "reject changing max allowed block size",
+			Bits:        0x0002, // Bit 1
+			IsAbstain:   false,
+			IsNo:        true,
+		}, {
+			Id:          "yes",
+			Description: "accept changing max allowed block size",
+			Bits:        0x0004, // Bit 2
+			IsAbstain:   false,
+			IsNo:        false,
+		}},
+	}
+}
+
+// voteSDiffAlgorithm returns the Vote describing VoteIDSDiffAlgorithm, the
+// agenda to change the stake difficulty algorithm as defined in DCP0001.
+func voteSDiffAlgorithm() Vote {
+	return Vote{
+		Id:          VoteIDSDiffAlgorithm,
+		Description: "Change stake difficulty algorithm as defined in DCP0001",
+		Mask:        0x0006, // Bits 1 and 2
+		Choices: []Choice{{
+			Id:          "abstain",
+			Description: "abstain voting for change",
+			Bits:        0x0000,
+			IsAbstain:   true,
+			IsNo:        false,
+		}, {
+			Id:          "no",
+			Description: "keep the existing algorithm",
+			Bits:        0x0002, // Bit 1
+			IsAbstain:   false,
+			IsNo:        true,
+		}, {
+			Id:          "yes",
+			Description: "change to the new algorithm",
+			Bits:        0x0004, // Bit 2
+			IsAbstain:   false,
+			IsNo:        false,
+		}},
+	}
+}
+
+// voteLNFeatures returns the Vote describing VoteIDLNFeatures, the agenda to
+// enable the features defined in DCP0002 and DCP0003 necessary to support
+// the Lightning Network (LN).
+func voteLNFeatures() Vote {
+	return Vote{
+		Id:          VoteIDLNFeatures,
+		Description: "Enable features defined in DCP0002 and DCP0003 necessary to support Lightning Network (LN)",
+		Mask:        0x0006, // Bits 1 and 2
+		Choices: []Choice{{
+			Id:          "abstain",
+			Description: "abstain voting for change",
+			Bits:        0x0000,
+			IsAbstain:   true,
+			IsNo:        false,
+		}, {
+			Id:          "no",
+			Description: "keep the existing consensus rules",
+			Bits:        0x0002, // Bit 1
+			IsAbstain:   false,
+			IsNo:        true,
+		}, {
+			Id:          "yes",
+			Description: "change to the new consensus rules",
+			Bits:        0x0004, // Bit 2
+			IsAbstain:   false,
+			IsNo:        false,
+		}},
+	}
+}
+
+// voteBlake3Pow returns the Vote describing VoteIDBlake3Pow, the agenda to
+// change the proof of work hashing algorithm to BLAKE3 as defined in
+// DCP0011.
+func voteBlake3Pow() Vote {
+	return Vote{
+		Id:          VoteIDBlake3Pow,
+		Description: "Change proof of work hashing algorithm to BLAKE3 as defined in DCP0011",
+		Mask:        0x0006, // Bits 1 and 2
+		Choices: []Choice{{
+			Id:          "abstain",
+			Description: "abstain voting for change",
+			Bits:        0x0000,
+			IsAbstain:   true,
+			IsNo:        false,
+		}, {
+			Id:          "no",
+			Description: "keep the existing hashing algorithm",
+			Bits:        0x0002, // Bit 1
+			IsAbstain:   false,
+			IsNo:        true,
+		}, {
+			Id:          "yes",
+			Description: "change to the BLAKE3 hashing algorithm",
+			Bits:        0x0004, // Bit 2
+			IsAbstain:   false,
+			IsNo:        false,
+		}},
+	}
+}
+
+// WithTreasury sets the organization treasury parameters on a Params being
+// built by NewParams.
+func WithTreasury(pkScript []byte, pkScriptVersion uint16, ledger []TokenPayout) ParamsOption {
+	return func(p *Params) {
+		p.OrganizationPkScript = pkScript
+		p.OrganizationPkScriptVersion = pkScriptVersion
+		p.BlockOneLedger = ledger
+	}
+}
+
+// Validate checks the Params for a handful of invariants that are currently
+// only enforced by convention across the hand-written network definitions,
+// returning a descriptive error for the first one it finds violated.  It is
+// intended to be called on any Params assembled via NewParams (and is
+// recommended for hand-written struct literals too) before the network is
+// registered or used.
+func (p *Params) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("chaincfg: params name must not be empty")
+	}
+
+	if p.GenesisHash == nil {
+		return fmt.Errorf("chaincfg: %s: genesis hash must be set", p.Name)
+	}
+	// GenesisBlock is not always available, e.g. for a Params that was
+	// loaded from a serialized params file via LoadParamsFromFile, which
+	// intentionally carries only the genesis hash.  Only cross-check the
+	// two when a genesis block is actually present.
+	if p.GenesisBlock != nil && p.GenesisBlock.Header.BlockHash() != *p.GenesisHash {
+		return fmt.Errorf("chaincfg: %s: genesis hash does not match genesis block", p.Name)
+	}
+
+	if got, want := p.StakeEnabledHeight, int64(p.CoinbaseMaturity)+int64(p.TicketMaturity); got != want {
+		return fmt.Errorf("chaincfg: %s: StakeEnabledHeight %d != CoinbaseMaturity+TicketMaturity %d",
+			p.Name, got, want)
+	}
+
+	if got, want := p.TargetTimespan, p.TargetTimePerBlock*time.Duration(p.WorkDiffWindowSize); got != want {
+		return fmt.Errorf("chaincfg: %s: TargetTimespan %s != TargetTimePerBlock*WorkDiffWindowSize %s",
+			p.Name, got, want)
+	}
+
+	if maxAllowed := uint32(p.TicketsPerBlock) * 4; p.MaxFreshStakePerBlock > maxAllowed {
+		return fmt.Errorf("chaincfg: %s: MaxFreshStakePerBlock %d exceeds TicketsPerBlock*4 %d",
+			p.Name, p.MaxFreshStakePerBlock, maxAllowed)
+	}
+
+	if total := uint32(p.WorkRewardProportion) + uint32(p.StakeRewardProportion) + uint32(p.BlockTaxProportion); total != totalSubsidyProportions {
+		return fmt.Errorf("chaincfg: %s: subsidy proportions must sum to %d, got %d",
+			p.Name, totalSubsidyProportions, total)
+	}
+
+	seenVoteIDs := make(map[string]bool)
+	for version, deployments := range p.Deployments {
+		for _, deployment := range deployments {
+			if seenVoteIDs[deployment.Vote.Id] {
+				return fmt.Errorf("chaincfg: %s: duplicate deployment vote ID %q at version %d",
+					p.Name, deployment.Vote.Id, version)
+			}
+			seenVoteIDs[deployment.Vote.Id] = true
+
+			for _, choice := range deployment.Vote.Choices {
+				if choice.Bits&^deployment.Vote.Mask != 0 {
+					return fmt.Errorf("chaincfg: %s: choice %q bits %#04x not covered by mask %#04x",
+						p.Name, choice.Id, choice.Bits, deployment.Vote.Mask)
+				}
+			}
+		}
+	}
+
+	lastSize := 0
+	for i, size := range p.MaximumBlockSizes {
+		if i > 0 && size < lastSize {
+			return fmt.Errorf("chaincfg: %s: MaximumBlockSizes must be monotonically non-decreasing, got %v",
+				p.Name, p.MaximumBlockSizes)
+		}
+		lastSize = size
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import "testing"
+
+// TestWithStakeParamsNoOverflow ensures MaxFreshStakePerBlock is computed
+// without wrapping for networks with a high enough TicketsPerBlock that
+// ticketsPerBlock*4 would overflow a uint16.
+func TestWithStakeParamsNoOverflow(t *testing.T) {
+	const ticketsPerBlock = 16384 // 16384*4 == 65536, overflows a uint16 to 0
+
+	p := NewParams("overflowtest", 0, WithStakeParams(40960, ticketsPerBlock, 256, 40960, 256, 1, 4096))
+	if want := uint32(ticketsPerBlock) * 4; p.MaxFreshStakePerBlock != want {
+		t.Fatalf("MaxFreshStakePerBlock: got %d, want %d", p.MaxFreshStakePerBlock, want)
+	}
+}
+
+// TestWithStakeParamsExplicitValidationHeight ensures StakeValidationHeight
+// is taken verbatim rather than derived from CoinbaseMaturity/TicketPoolSize,
+// since MainNet's value does not follow that formula.
+func TestWithStakeParamsExplicitValidationHeight(t *testing.T) {
+	const wantHeight = 4096
+
+	p := NewParams("mainnetlike", 0, WithStakeParams(40960, 5, 256, 40960, 256, 1, wantHeight))
+	if p.StakeValidationHeight != wantHeight {
+		t.Fatalf("StakeValidationHeight: got %d, want %d", p.StakeValidationHeight, wantHeight)
+	}
+}
+
+func validTestParams() *Params {
+	hash := RegNetParams.GenesisHash
+	return NewParams("validtest", 0,
+		WithSubsidyParams(50000000000, 100, 101, 128, 6, 3, 1),
+		WithStakeParams(64, 5, 16, 384, 16, 1, 16+64*2),
+		func(p *Params) {
+			p.GenesisHash = hash
+			p.TargetTimePerBlock = RegNetParams.TargetTimePerBlock
+			p.WorkDiffWindowSize = RegNetParams.WorkDiffWindowSize
+			p.TargetTimespan = RegNetParams.TargetTimePerBlock * 8
+			p.MaximumBlockSizes = []int{1000000, 1310720}
+		},
+	)
+}
+
+func TestValidateAcceptsWellFormedParams(t *testing.T) {
+	if err := validTestParams().Validate(); err != nil {
+		t.Fatalf("unexpected error from Validate: %v", err)
+	}
+}
+
+func TestValidateRejectsBadSubsidyProportions(t *testing.T) {
+	p := validTestParams()
+	p.BlockTaxProportion = 2 // 6+3+2 = 11, not 10
+
+	if err := p.Validate(); err == nil {
+		t.Fatal("expected Validate to reject subsidy proportions that do not sum to 10")
+	}
+}
+
+func TestValidateRejectsStakeEnabledHeightMismatch(t *testing.T) {
+	p := validTestParams()
+	p.StakeEnabledHeight++
+
+	if err := p.Validate(); err == nil {
+		t.Fatal("expected Validate to reject a mismatched StakeEnabledHeight")
+	}
+}
+
+func TestValidateRejectsExcessiveMaxFreshStake(t *testing.T) {
+	p := validTestParams()
+	p.MaxFreshStakePerBlock = uint32(p.TicketsPerBlock)*4 + 1
+
+	if err := p.Validate(); err == nil {
+		t.Fatal("expected Validate to reject MaxFreshStakePerBlock exceeding TicketsPerBlock*4")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import "time"
+
+// Deployment returns the consensus deployment version and definition for the
+// provided vote ID, along with false if no such deployment is defined for
+// this network.  Callers that need to populate a stakeversion field can use
+// the returned version directly instead of walking the Deployments map by
+// hand.
+func (p *Params) Deployment(voteID string) (version uint32, d *ConsensusDeployment, ok bool) {
+	for v, deployments := range p.Deployments {
+		for i := range deployments {
+			if deployments[i].Vote.Id == voteID {
+				return v, &deployments[i], true
+			}
+		}
+	}
+	return 0, nil, false
+}
+
+// VoteByID returns the Vote with the provided ID defined for this network,
+// along with false if no such vote is defined.
+func (p *Params) VoteByID(voteID string) (*Vote, bool) {
+	_, d, ok := p.Deployment(voteID)
+	if !ok {
+		return nil, false
+	}
+	return &d.Vote, true
+}
+
+// ChoiceByID returns the Choice with the provided ID defined for the vote,
+// along with false if no such choice is defined.
+func (v *Vote) ChoiceByID(id string) (*Choice, bool) {
+	for i := range v.Choices {
+		if v.Choices[i].Id == id {
+			return &v.Choices[i], true
+		}
+	}
+	return nil, false
+}
+
+// AbstainChoice returns the Choice that represents abstaining from the vote,
+// or nil if the vote does not define one.
+func (v *Vote) AbstainChoice() *Choice {
+	for i := range v.Choices {
+		if v.Choices[i].IsAbstain {
+			return &v.Choices[i]
+		}
+	}
+	return nil
+}
+
+// NoChoice returns the Choice that represents voting no on the change, or
+// nil if the vote does not define one.
+func (v *Vote) NoChoice() *Choice {
+	for i := range v.Choices {
+		if v.Choices[i].IsNo {
+			return &v.Choices[i]
+		}
+	}
+	return nil
+}
+
+// IsActiveAt returns whether the deployment's voting window is open at the
+// provided time; that is, whether t falls on or after StartTime and before
+// ExpireTime.  It does not indicate whether the agenda itself has been
+// locked in or activated on the chain, only whether voting on it is
+// currently possible.
+func (d *ConsensusDeployment) IsActiveAt(t time.Time) bool {
+	unix := t.Unix()
+	return unix >= int64(d.StartTime) && unix < int64(d.ExpireTime)
+}
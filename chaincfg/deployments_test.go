@@ -0,0 +1,96 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeploymentLookup(t *testing.T) {
+	version, d, ok := RegNetParams.Deployment(VoteIDBlake3Pow)
+	if !ok {
+		t.Fatal("Deployment did not find VoteIDBlake3Pow")
+	}
+	if version != 11 {
+		t.Fatalf("Deployment version: got %d, want 11", version)
+	}
+	if d.Vote.Id != VoteIDBlake3Pow {
+		t.Fatalf("Deployment vote ID: got %q, want %q", d.Vote.Id, VoteIDBlake3Pow)
+	}
+
+	if _, _, ok := RegNetParams.Deployment("nonexistent-vote-id"); ok {
+		t.Fatal("Deployment found a result for a vote ID that does not exist")
+	}
+}
+
+func TestVoteByID(t *testing.T) {
+	vote, ok := RegNetParams.VoteByID(VoteIDMaxBlockSize)
+	if !ok {
+		t.Fatal("VoteByID did not find VoteIDMaxBlockSize")
+	}
+	if vote.Id != VoteIDMaxBlockSize {
+		t.Fatalf("vote ID: got %q, want %q", vote.Id, VoteIDMaxBlockSize)
+	}
+
+	if _, ok := RegNetParams.VoteByID("nonexistent-vote-id"); ok {
+		t.Fatal("VoteByID found a result for a vote ID that does not exist")
+	}
+}
+
+func TestChoiceByID(t *testing.T) {
+	vote, ok := RegNetParams.VoteByID(VoteIDMaxBlockSize)
+	if !ok {
+		t.Fatal("VoteByID did not find VoteIDMaxBlockSize")
+	}
+
+	choice, ok := vote.ChoiceByID("yes")
+	if !ok {
+		t.Fatal("ChoiceByID did not find the yes choice")
+	}
+	if choice.IsAbstain || choice.IsNo {
+		t.Fatal("yes choice incorrectly flagged as abstain or no")
+	}
+
+	if _, ok := vote.ChoiceByID("nonexistent-choice"); ok {
+		t.Fatal("ChoiceByID found a result for a choice that does not exist")
+	}
+}
+
+func TestAbstainAndNoChoice(t *testing.T) {
+	vote, ok := RegNetParams.VoteByID(VoteIDMaxBlockSize)
+	if !ok {
+		t.Fatal("VoteByID did not find VoteIDMaxBlockSize")
+	}
+
+	abstain := vote.AbstainChoice()
+	if abstain == nil || !abstain.IsAbstain {
+		t.Fatal("AbstainChoice did not return the abstain choice")
+	}
+
+	no := vote.NoChoice()
+	if no == nil || !no.IsNo {
+		t.Fatal("NoChoice did not return the no choice")
+	}
+}
+
+func TestConsensusDeploymentIsActiveAt(t *testing.T) {
+	d := &ConsensusDeployment{StartTime: 100, ExpireTime: 200}
+
+	cases := []struct {
+		unix int64
+		want bool
+	}{
+		{unix: 99, want: false},
+		{unix: 100, want: true},
+		{unix: 150, want: true},
+		{unix: 200, want: false},
+	}
+	for _, c := range cases {
+		if got := d.IsActiveAt(time.Unix(c.unix, 0)); got != c.want {
+			t.Errorf("IsActiveAt(%d): got %v, want %v", c.unix, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"math"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// MainNetParams defines the network parameters for the main Decred network.
+var MainNetParams = Params{
+	Name:        "mainnet",
+	Net:         wire.MainNet,
+	DefaultPort: "9108",
+	DNSSeeds: []string{
+		"mainnet-seed.constella.network",
+		"mainnet-seed2.constella.network",
+	},
+
+	// Chain parameters
+	GenesisBlock:             &mainNetGenesisBlock,
+	GenesisHash:              &mainNetGenesisHash,
+	PowLimit:                 mainNetPowLimit,
+	PowLimitBits:             0x1d00ffff,
+	PowHashAlgorithm:         PoWHashBlake256,
+	ReduceMinDifficulty:      false,
+	MinDiffReductionTime:     0,
+	GenerateSupported:        false,
+	MaximumBlockSizes:        []int{1310720},
+	MaxTxSize:                1000000,
+	TargetTimePerBlock:       time.Minute * 5,
+	WorkDiffAlpha:            1,
+	WorkDiffWindowSize:       144,
+	WorkDiffWindows:          20,
+	TargetTimespan:           time.Minute * 5 * 144, // TimePerBlock * WindowSize
+	RetargetAdjustmentFactor: 4,
+
+	// Subsidy parameters.
+	BaseSubsidy:              3119582664,
+	MulSubsidy:               100,
+	DivSubsidy:               101,
+	SubsidyReductionInterval: 6144,
+	WorkRewardProportion:     6,
+	StakeRewardProportion:    3,
+	BlockTaxProportion:       1,
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints: nil,
+
+	// MinKnownChainWork is intentionally left unset until a known-good
+	// chain work value for this fork's main network has been recorded.
+	MinKnownChainWork: nil,
+
+	// Consensus rule change deployments.
+	RuleChangeActivationQuorum:     4032, // 10 % of RuleChangeActivationInterval * TicketsPerBlock
+	RuleChangeActivationMultiplier: 3,    // 75%
+	RuleChangeActivationDivisor:    4,
+	RuleChangeActivationInterval:   8064, // 4 weeks
+	Deployments: map[uint32][]ConsensusDeployment{
+		4:  deploymentAt(voteMaxBlockSize(), 1493164800, 1524700800), // Apr 26th, 2017 - Apr 26th, 2018
+		5:  deploymentAt(voteSDiffAlgorithm(), 1493164800, 1524700800), // Apr 26th, 2017 - Apr 26th, 2018
+		6:  deploymentAt(voteLNFeatures(), 1548633600, 1580169600),   // Jan 28th, 2019 - Jan 28th, 2020
+		11: deploymentAt(voteBlake3Pow(), 0, math.MaxInt64),          // Always available, never expires
+	},
+
+	BlockEnforceNumRequired: 750,
+	BlockRejectNumRequired:  950,
+	BlockUpgradeNumToCheck:  1000,
+
+	AcceptNonStdTxs: false,
+
+	// Address encoding magics
+	NetworkAddressPrefix: "XCM",
+	PubKeyAddrID:         [2]byte{0x13, 0x86}, // starts with Dk
+	PubKeyHashAddrID:     [2]byte{0x07, 0x3f}, // starts with Ds
+	PKHEdwardsAddrID:     [2]byte{0x07, 0x1f}, // starts with De
+	PKHSchnorrAddrID:     [2]byte{0x07, 0x01}, // starts with DS
+	ScriptHashAddrID:     [2]byte{0x07, 0x1a}, // starts with Dc
+	PrivateKeyID:         [2]byte{0x22, 0xde}, // starts with Pm
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x02, 0xfd, 0xa4, 0xe8}, // starts with dprv
+	HDPublicKeyID:  [4]byte{0x02, 0xfd, 0xa9, 0x26}, // starts with dpub
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation.
+	SLIP0044CoinType: 42, // SLIP0044, Decred
+	LegacyCoinType:   20, // for backwards compatibility
+
+	// Decred PoS parameters
+	MinimumStakeDiff:        200000000,
+	TicketPoolSize:          40960,
+	TicketsPerBlock:         5,
+	TicketMaturity:          256,
+	TicketExpiry:            40960, // TicketPoolSize * 1
+	CoinbaseMaturity:        256,
+	SStxChangeMaturity:      1,
+	TicketPoolSizeWeight:    4,
+	StakeDiffAlpha:          1,
+	StakeDiffWindowSize:     144,
+	StakeDiffWindows:        20,
+	StakeVersionInterval:    144 * 2 * 7,
+	MaxFreshStakePerBlock:   20,               // 4*TicketsPerBlock
+	StakeEnabledHeight:      256 + 256,        // CoinbaseMaturity + TicketMaturity
+	StakeValidationHeight:   4096,             // Defined by DCP0001
+	StakeBaseSigScript:      []byte{0x00, 0x00},
+	StakeMajorityMultiplier: 3,
+	StakeMajorityDivisor:    4,
+
+	// Decred organization related parameters.
+	OrganizationPkScript:        hexDecode("a914f5916158e3e2c4551c1796708db8367207ed13bb87"),
+	OrganizationPkScriptVersion: 0,
+	BlockOneLedger:              BlockOneLedgerMainNet,
+}
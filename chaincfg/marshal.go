@@ -0,0 +1,581 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+// checkpointJSON is the hex-encoded representation of a Checkpoint.
+type checkpointJSON struct {
+	Height int64  `json:"height"`
+	Hash   string `json:"hash"`
+}
+
+// choiceJSON is the snake_case, hex-encoded representation of a Choice.
+// Bits is emitted as a hex string to match the "address magics as hex"
+// convention used for the rest of the document rather than as a bare
+// decimal, which would be the only numeric field in the document not
+// self-describing as a bitmask.
+type choiceJSON struct {
+	Id          string `json:"id"`
+	Description string `json:"description"`
+	Bits        string `json:"bits"`
+	IsAbstain   bool   `json:"is_abstain"`
+	IsNo        bool   `json:"is_no"`
+}
+
+// voteJSON is the snake_case, hex-encoded representation of a Vote.
+type voteJSON struct {
+	Id          string       `json:"id"`
+	Description string       `json:"description"`
+	Mask        string       `json:"mask"`
+	Choices     []choiceJSON `json:"choices"`
+}
+
+// consensusDeploymentJSON is the snake_case representation of a
+// ConsensusDeployment.
+type consensusDeploymentJSON struct {
+	Vote       voteJSON `json:"vote"`
+	StartTime  uint64   `json:"start_time"`
+	ExpireTime uint64   `json:"expire_time"`
+}
+
+// paramsJSON mirrors Params for the purposes of deterministic JSON
+// serialization.  Fields that are fixed-size byte arrays in Params are
+// represented as hex strings so that non-Go tooling (block explorers, SPV
+// clients, wallets written in other languages) does not need to reason
+// about Go array encoding, and every field name is snake_case so the
+// document is uniform throughout, including the nested deployments/votes/
+// choices. Deployments is keyed by the decimal string form of the
+// deployment version rather than uint32, since JSON object keys are always
+// strings.
+//
+// GenesisBlock is intentionally omitted: it is reconstructed by the caller
+// from the network's genesis parameters rather than round-tripped, since
+// wire.MsgBlock does not define a canonical JSON form of its own.  Validate
+// only cross-checks GenesisHash against GenesisBlock when the latter is
+// present, so a round-tripped Params still validates successfully.
+type paramsJSON struct {
+	Name        string   `json:"name"`
+	Net         uint32   `json:"net"`
+	DefaultPort string   `json:"default_port"`
+	DNSSeeds    []string `json:"dns_seeds,omitempty"`
+
+	GenesisHash string `json:"genesis_hash"`
+
+	PowLimit                  string `json:"pow_limit"`
+	PowLimitBits              uint32 `json:"pow_limit_bits"`
+	PowHashAlgorithm          int    `json:"pow_hash_algorithm"`
+	Blake3PowActivationHeight int64  `json:"blake3_pow_activation_height"`
+	ReduceMinDifficulty       bool   `json:"reduce_min_difficulty"`
+	MinDiffReductionTime      int64  `json:"min_diff_reduction_time"`
+	GenerateSupported         bool   `json:"generate_supported"`
+	MaximumBlockSizes         []int  `json:"maximum_block_sizes"`
+	MaxTxSize                 int    `json:"max_tx_size"`
+	TargetTimePerBlock        int64  `json:"target_time_per_block"`
+	WorkDiffAlpha             int64  `json:"work_diff_alpha"`
+	WorkDiffWindowSize        int64  `json:"work_diff_window_size"`
+	WorkDiffWindows           int64  `json:"work_diff_windows"`
+	TargetTimespan            int64  `json:"target_timespan"`
+	RetargetAdjustmentFactor  int64  `json:"retarget_adjustment_factor"`
+
+	BaseSubsidy              int64  `json:"base_subsidy"`
+	MulSubsidy               int64  `json:"mul_subsidy"`
+	DivSubsidy               int64  `json:"div_subsidy"`
+	SubsidyReductionInterval int64  `json:"subsidy_reduction_interval"`
+	WorkRewardProportion     uint16 `json:"work_reward_proportion"`
+	StakeRewardProportion    uint16 `json:"stake_reward_proportion"`
+	BlockTaxProportion       uint16 `json:"block_tax_proportion"`
+
+	Checkpoints []checkpointJSON `json:"checkpoints,omitempty"`
+
+	MinKnownChainWork string `json:"min_known_chain_work,omitempty"`
+
+	RuleChangeActivationQuorum     uint32 `json:"rule_change_activation_quorum"`
+	RuleChangeActivationMultiplier int64  `json:"rule_change_activation_multiplier"`
+	RuleChangeActivationDivisor    int64  `json:"rule_change_activation_divisor"`
+	RuleChangeActivationInterval   uint32 `json:"rule_change_activation_interval"`
+
+	Deployments map[string][]consensusDeploymentJSON `json:"deployments,omitempty"`
+
+	BlockEnforceNumRequired uint64 `json:"block_enforce_num_required"`
+	BlockRejectNumRequired  uint64 `json:"block_reject_num_required"`
+	BlockUpgradeNumToCheck  uint64 `json:"block_upgrade_num_to_check"`
+
+	AcceptNonStdTxs bool `json:"accept_non_std_txs"`
+
+	NetworkAddressPrefix string `json:"network_address_prefix"`
+	PubKeyAddrID         string `json:"pub_key_addr_id"`
+	PubKeyHashAddrID     string `json:"pub_key_hash_addr_id"`
+	PKHEdwardsAddrID     string `json:"pkh_edwards_addr_id"`
+	PKHSchnorrAddrID     string `json:"pkh_schnorr_addr_id"`
+	ScriptHashAddrID     string `json:"script_hash_addr_id"`
+	PrivateKeyID         string `json:"private_key_id"`
+
+	HDPrivateKeyID   string `json:"hd_private_key_id"`
+	HDPublicKeyID    string `json:"hd_public_key_id"`
+	SLIP0044CoinType uint32 `json:"slip0044_coin_type"`
+	LegacyCoinType   uint32 `json:"legacy_coin_type"`
+
+	MinimumStakeDiff        int64  `json:"minimum_stake_diff"`
+	TicketPoolSize          uint16 `json:"ticket_pool_size"`
+	TicketsPerBlock         uint16 `json:"tickets_per_block"`
+	TicketMaturity          uint16 `json:"ticket_maturity"`
+	TicketExpiry            uint32 `json:"ticket_expiry"`
+	CoinbaseMaturity        uint16 `json:"coinbase_maturity"`
+	SStxChangeMaturity      int64  `json:"sstx_change_maturity"`
+	TicketPoolSizeWeight    uint16 `json:"ticket_pool_size_weight"`
+	StakeDiffAlpha          int64  `json:"stake_diff_alpha"`
+	StakeDiffWindowSize     int64  `json:"stake_diff_window_size"`
+	StakeDiffWindows        int64  `json:"stake_diff_windows"`
+	StakeVersionInterval    int64  `json:"stake_version_interval"`
+	MaxFreshStakePerBlock   uint32 `json:"max_fresh_stake_per_block"`
+	StakeEnabledHeight      int64  `json:"stake_enabled_height"`
+	StakeValidationHeight   int64  `json:"stake_validation_height"`
+	StakeBaseSigScript      string `json:"stake_base_sig_script"`
+	StakeMajorityMultiplier int64  `json:"stake_majority_multiplier"`
+	StakeMajorityDivisor    int64  `json:"stake_majority_divisor"`
+
+	OrganizationPkScript        string        `json:"organization_pk_script"`
+	OrganizationPkScriptVersion uint16        `json:"organization_pk_script_version"`
+	BlockOneLedger              []TokenPayout `json:"block_one_ledger,omitempty"`
+}
+
+// choiceToJSON and choiceFromJSON convert between Choice and its
+// hex-encoded, snake_case mirror.
+func choiceToJSON(c Choice) choiceJSON {
+	return choiceJSON{
+		Id:          c.Id,
+		Description: c.Description,
+		Bits:        strconv.FormatUint(uint64(c.Bits), 16),
+		IsAbstain:   c.IsAbstain,
+		IsNo:        c.IsNo,
+	}
+}
+
+func choiceFromJSON(cj choiceJSON) (Choice, error) {
+	bits, err := strconv.ParseUint(cj.Bits, 16, 16)
+	if err != nil {
+		return Choice{}, fmt.Errorf("invalid choice bits %q: %w", cj.Bits, err)
+	}
+	return Choice{
+		Id:          cj.Id,
+		Description: cj.Description,
+		Bits:        uint16(bits),
+		IsAbstain:   cj.IsAbstain,
+		IsNo:        cj.IsNo,
+	}, nil
+}
+
+// voteToJSON and voteFromJSON convert between Vote and its hex-encoded,
+// snake_case mirror.
+func voteToJSON(v Vote) voteJSON {
+	choices := make([]choiceJSON, len(v.Choices))
+	for i, c := range v.Choices {
+		choices[i] = choiceToJSON(c)
+	}
+	return voteJSON{
+		Id:          v.Id,
+		Description: v.Description,
+		Mask:        strconv.FormatUint(uint64(v.Mask), 16),
+		Choices:     choices,
+	}
+}
+
+func voteFromJSON(vj voteJSON) (Vote, error) {
+	mask, err := strconv.ParseUint(vj.Mask, 16, 16)
+	if err != nil {
+		return Vote{}, fmt.Errorf("invalid vote mask %q: %w", vj.Mask, err)
+	}
+	choices := make([]Choice, len(vj.Choices))
+	for i, cj := range vj.Choices {
+		choice, err := choiceFromJSON(cj)
+		if err != nil {
+			return Vote{}, err
+		}
+		choices[i] = choice
+	}
+	return Vote{
+		Id:          vj.Id,
+		Description: vj.Description,
+		Mask:        uint16(mask),
+		Choices:     choices,
+	}, nil
+}
+
+// deploymentsToJSON converts a version-keyed deployments map to the
+// string-keyed, snake_case form used for serialization.
+func deploymentsToJSON(deployments map[uint32][]ConsensusDeployment) map[string][]consensusDeploymentJSON {
+	if deployments == nil {
+		return nil
+	}
+	out := make(map[string][]consensusDeploymentJSON, len(deployments))
+	for version, ds := range deployments {
+		converted := make([]consensusDeploymentJSON, len(ds))
+		for i, d := range ds {
+			converted[i] = consensusDeploymentJSON{
+				Vote:       voteToJSON(d.Vote),
+				StartTime:  d.StartTime,
+				ExpireTime: d.ExpireTime,
+			}
+		}
+		out[strconv.FormatUint(uint64(version), 10)] = converted
+	}
+	return out
+}
+
+// deploymentsFromJSON converts a string-keyed, snake_case deployments map
+// back to its version-keyed form, rejecting keys that are not valid
+// deployment versions.
+func deploymentsFromJSON(deployments map[string][]consensusDeploymentJSON) (map[uint32][]ConsensusDeployment, error) {
+	if deployments == nil {
+		return nil, nil
+	}
+	out := make(map[uint32][]ConsensusDeployment, len(deployments))
+	for key, ds := range deployments {
+		version, err := strconv.ParseUint(key, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid deployment version %q: %w", key, err)
+		}
+		converted := make([]ConsensusDeployment, len(ds))
+		for i, dj := range ds {
+			vote, err := voteFromJSON(dj.Vote)
+			if err != nil {
+				return nil, fmt.Errorf("invalid deployment version %q: %w", key, err)
+			}
+			converted[i] = ConsensusDeployment{
+				Vote:       vote,
+				StartTime:  dj.StartTime,
+				ExpireTime: dj.ExpireTime,
+			}
+		}
+		out[uint32(version)] = converted
+	}
+	return out, nil
+}
+
+// toParamsJSON converts p into its hex-encoded JSON representation.
+func (p *Params) toParamsJSON() *paramsJSON {
+	powLimit := ""
+	if p.PowLimit != nil {
+		powLimit = p.PowLimit.Text(16)
+	}
+	minKnownChainWork := ""
+	if p.MinKnownChainWork != nil {
+		minKnownChainWork = p.MinKnownChainWork.Text(16)
+	}
+	genesisHash := ""
+	if p.GenesisHash != nil {
+		genesisHash = p.GenesisHash.String()
+	}
+
+	var checkpoints []checkpointJSON
+	if len(p.Checkpoints) > 0 {
+		checkpoints = make([]checkpointJSON, len(p.Checkpoints))
+		for i, cp := range p.Checkpoints {
+			checkpoints[i] = checkpointJSON{Height: cp.Height, Hash: cp.Hash.String()}
+		}
+	}
+
+	return &paramsJSON{
+		Name:                           p.Name,
+		Net:                            uint32(p.Net),
+		DefaultPort:                    p.DefaultPort,
+		DNSSeeds:                       p.DNSSeeds,
+		GenesisHash:                    genesisHash,
+		PowLimit:                       powLimit,
+		PowLimitBits:                   p.PowLimitBits,
+		PowHashAlgorithm:               int(p.PowHashAlgorithm),
+		Blake3PowActivationHeight:      p.Blake3PowActivationHeight,
+		ReduceMinDifficulty:            p.ReduceMinDifficulty,
+		MinDiffReductionTime:           int64(p.MinDiffReductionTime),
+		GenerateSupported:              p.GenerateSupported,
+		MaximumBlockSizes:              p.MaximumBlockSizes,
+		MaxTxSize:                      p.MaxTxSize,
+		TargetTimePerBlock:             int64(p.TargetTimePerBlock),
+		WorkDiffAlpha:                  p.WorkDiffAlpha,
+		WorkDiffWindowSize:             p.WorkDiffWindowSize,
+		WorkDiffWindows:                p.WorkDiffWindows,
+		TargetTimespan:                 int64(p.TargetTimespan),
+		RetargetAdjustmentFactor:       p.RetargetAdjustmentFactor,
+		BaseSubsidy:                    p.BaseSubsidy,
+		MulSubsidy:                     p.MulSubsidy,
+		DivSubsidy:                     p.DivSubsidy,
+		SubsidyReductionInterval:       p.SubsidyReductionInterval,
+		WorkRewardProportion:           p.WorkRewardProportion,
+		StakeRewardProportion:          p.StakeRewardProportion,
+		BlockTaxProportion:             p.BlockTaxProportion,
+		Checkpoints:                    checkpoints,
+		MinKnownChainWork:              minKnownChainWork,
+		RuleChangeActivationQuorum:     p.RuleChangeActivationQuorum,
+		RuleChangeActivationMultiplier: p.RuleChangeActivationMultiplier,
+		RuleChangeActivationDivisor:    p.RuleChangeActivationDivisor,
+		RuleChangeActivationInterval:   p.RuleChangeActivationInterval,
+		Deployments:                    deploymentsToJSON(p.Deployments),
+		BlockEnforceNumRequired:        p.BlockEnforceNumRequired,
+		BlockRejectNumRequired:         p.BlockRejectNumRequired,
+		BlockUpgradeNumToCheck:         p.BlockUpgradeNumToCheck,
+		AcceptNonStdTxs:                p.AcceptNonStdTxs,
+		NetworkAddressPrefix:           p.NetworkAddressPrefix,
+		PubKeyAddrID:                   hex.EncodeToString(p.PubKeyAddrID[:]),
+		PubKeyHashAddrID:               hex.EncodeToString(p.PubKeyHashAddrID[:]),
+		PKHEdwardsAddrID:               hex.EncodeToString(p.PKHEdwardsAddrID[:]),
+		PKHSchnorrAddrID:               hex.EncodeToString(p.PKHSchnorrAddrID[:]),
+		ScriptHashAddrID:               hex.EncodeToString(p.ScriptHashAddrID[:]),
+		PrivateKeyID:                   hex.EncodeToString(p.PrivateKeyID[:]),
+		HDPrivateKeyID:                 hex.EncodeToString(p.HDPrivateKeyID[:]),
+		HDPublicKeyID:                  hex.EncodeToString(p.HDPublicKeyID[:]),
+		SLIP0044CoinType:               p.SLIP0044CoinType,
+		LegacyCoinType:                 p.LegacyCoinType,
+		MinimumStakeDiff:               p.MinimumStakeDiff,
+		TicketPoolSize:                 p.TicketPoolSize,
+		TicketsPerBlock:                p.TicketsPerBlock,
+		TicketMaturity:                 p.TicketMaturity,
+		TicketExpiry:                   p.TicketExpiry,
+		CoinbaseMaturity:               p.CoinbaseMaturity,
+		SStxChangeMaturity:             p.SStxChangeMaturity,
+		TicketPoolSizeWeight:           p.TicketPoolSizeWeight,
+		StakeDiffAlpha:                 p.StakeDiffAlpha,
+		StakeDiffWindowSize:            p.StakeDiffWindowSize,
+		StakeDiffWindows:               p.StakeDiffWindows,
+		StakeVersionInterval:           p.StakeVersionInterval,
+		MaxFreshStakePerBlock:          p.MaxFreshStakePerBlock,
+		StakeEnabledHeight:             p.StakeEnabledHeight,
+		StakeValidationHeight:          p.StakeValidationHeight,
+		StakeBaseSigScript:             hex.EncodeToString(p.StakeBaseSigScript),
+		StakeMajorityMultiplier:        p.StakeMajorityMultiplier,
+		StakeMajorityDivisor:           p.StakeMajorityDivisor,
+		OrganizationPkScript:           hex.EncodeToString(p.OrganizationPkScript),
+		OrganizationPkScriptVersion:    p.OrganizationPkScriptVersion,
+		BlockOneLedger:                 p.BlockOneLedger,
+	}
+}
+
+// fromParamsJSON populates p from its hex-encoded JSON representation.
+func (p *Params) fromParamsJSON(pj *paramsJSON) error {
+	deployments, err := deploymentsFromJSON(pj.Deployments)
+	if err != nil {
+		return fmt.Errorf("chaincfg: invalid deployments: %w", err)
+	}
+
+	*p = Params{
+		Name:                           pj.Name,
+		Net:                            wire.CurrencyNet(pj.Net),
+		DefaultPort:                    pj.DefaultPort,
+		DNSSeeds:                       pj.DNSSeeds,
+		PowLimitBits:                   pj.PowLimitBits,
+		PowHashAlgorithm:               PowHashAlgorithm(pj.PowHashAlgorithm),
+		Blake3PowActivationHeight:      pj.Blake3PowActivationHeight,
+		ReduceMinDifficulty:            pj.ReduceMinDifficulty,
+		MinDiffReductionTime:           time.Duration(pj.MinDiffReductionTime),
+		GenerateSupported:              pj.GenerateSupported,
+		MaximumBlockSizes:              pj.MaximumBlockSizes,
+		MaxTxSize:                      pj.MaxTxSize,
+		TargetTimePerBlock:             time.Duration(pj.TargetTimePerBlock),
+		WorkDiffAlpha:                  pj.WorkDiffAlpha,
+		WorkDiffWindowSize:             pj.WorkDiffWindowSize,
+		WorkDiffWindows:                pj.WorkDiffWindows,
+		TargetTimespan:                 time.Duration(pj.TargetTimespan),
+		RetargetAdjustmentFactor:       pj.RetargetAdjustmentFactor,
+		BaseSubsidy:                    pj.BaseSubsidy,
+		MulSubsidy:                     pj.MulSubsidy,
+		DivSubsidy:                     pj.DivSubsidy,
+		SubsidyReductionInterval:       pj.SubsidyReductionInterval,
+		WorkRewardProportion:           pj.WorkRewardProportion,
+		StakeRewardProportion:          pj.StakeRewardProportion,
+		BlockTaxProportion:             pj.BlockTaxProportion,
+		RuleChangeActivationQuorum:     pj.RuleChangeActivationQuorum,
+		RuleChangeActivationMultiplier: pj.RuleChangeActivationMultiplier,
+		RuleChangeActivationDivisor:    pj.RuleChangeActivationDivisor,
+		RuleChangeActivationInterval:   pj.RuleChangeActivationInterval,
+		Deployments:                    deployments,
+		BlockEnforceNumRequired:        pj.BlockEnforceNumRequired,
+		BlockRejectNumRequired:         pj.BlockRejectNumRequired,
+		BlockUpgradeNumToCheck:         pj.BlockUpgradeNumToCheck,
+		AcceptNonStdTxs:                pj.AcceptNonStdTxs,
+		NetworkAddressPrefix:           pj.NetworkAddressPrefix,
+		SLIP0044CoinType:               pj.SLIP0044CoinType,
+		LegacyCoinType:                 pj.LegacyCoinType,
+		MinimumStakeDiff:               pj.MinimumStakeDiff,
+		TicketPoolSize:                 pj.TicketPoolSize,
+		TicketsPerBlock:                pj.TicketsPerBlock,
+		TicketMaturity:                 pj.TicketMaturity,
+		TicketExpiry:                   pj.TicketExpiry,
+		CoinbaseMaturity:               pj.CoinbaseMaturity,
+		SStxChangeMaturity:             pj.SStxChangeMaturity,
+		TicketPoolSizeWeight:           pj.TicketPoolSizeWeight,
+		StakeDiffAlpha:                 pj.StakeDiffAlpha,
+		StakeDiffWindowSize:            pj.StakeDiffWindowSize,
+		StakeDiffWindows:               pj.StakeDiffWindows,
+		StakeVersionInterval:           pj.StakeVersionInterval,
+		MaxFreshStakePerBlock:          pj.MaxFreshStakePerBlock,
+		StakeEnabledHeight:             pj.StakeEnabledHeight,
+		StakeValidationHeight:          pj.StakeValidationHeight,
+		StakeMajorityMultiplier:        pj.StakeMajorityMultiplier,
+		StakeMajorityDivisor:           pj.StakeMajorityDivisor,
+		OrganizationPkScriptVersion:    pj.OrganizationPkScriptVersion,
+		BlockOneLedger:                 pj.BlockOneLedger,
+	}
+
+	if pj.GenesisHash != "" {
+		hash, err := chainhash.NewHashFromStr(pj.GenesisHash)
+		if err != nil {
+			return fmt.Errorf("chaincfg: invalid genesis_hash: %w", err)
+		}
+		p.GenesisHash = hash
+	}
+
+	if pj.PowLimit != "" {
+		limit, ok := new(big.Int).SetString(pj.PowLimit, 16)
+		if !ok {
+			return fmt.Errorf("chaincfg: invalid pow_limit: %q", pj.PowLimit)
+		}
+		p.PowLimit = limit
+	}
+
+	if pj.MinKnownChainWork != "" {
+		work, ok := new(big.Int).SetString(pj.MinKnownChainWork, 16)
+		if !ok {
+			return fmt.Errorf("chaincfg: invalid min_known_chain_work: %q", pj.MinKnownChainWork)
+		}
+		p.MinKnownChainWork = work
+	}
+
+	if len(pj.Checkpoints) > 0 {
+		checkpoints := make([]Checkpoint, len(pj.Checkpoints))
+		for i, cp := range pj.Checkpoints {
+			hash, err := chainhash.NewHashFromStr(cp.Hash)
+			if err != nil {
+				return fmt.Errorf("chaincfg: invalid checkpoint hash %q: %w", cp.Hash, err)
+			}
+			checkpoints[i] = Checkpoint{Height: cp.Height, Hash: hash}
+		}
+		p.Checkpoints = checkpoints
+	}
+
+	var err2 error
+	if p.PubKeyAddrID, err2 = decodeAddrID(pj.PubKeyAddrID); err2 != nil {
+		return fmt.Errorf("chaincfg: invalid pub_key_addr_id: %w", err2)
+	}
+	if p.PubKeyHashAddrID, err2 = decodeAddrID(pj.PubKeyHashAddrID); err2 != nil {
+		return fmt.Errorf("chaincfg: invalid pub_key_hash_addr_id: %w", err2)
+	}
+	if p.PKHEdwardsAddrID, err2 = decodeAddrID(pj.PKHEdwardsAddrID); err2 != nil {
+		return fmt.Errorf("chaincfg: invalid pkh_edwards_addr_id: %w", err2)
+	}
+	if p.PKHSchnorrAddrID, err2 = decodeAddrID(pj.PKHSchnorrAddrID); err2 != nil {
+		return fmt.Errorf("chaincfg: invalid pkh_schnorr_addr_id: %w", err2)
+	}
+	if p.ScriptHashAddrID, err2 = decodeAddrID(pj.ScriptHashAddrID); err2 != nil {
+		return fmt.Errorf("chaincfg: invalid script_hash_addr_id: %w", err2)
+	}
+	if p.PrivateKeyID, err2 = decodeAddrID(pj.PrivateKeyID); err2 != nil {
+		return fmt.Errorf("chaincfg: invalid private_key_id: %w", err2)
+	}
+	if p.HDPrivateKeyID, err2 = decodeHDKeyID(pj.HDPrivateKeyID); err2 != nil {
+		return fmt.Errorf("chaincfg: invalid hd_private_key_id: %w", err2)
+	}
+	if p.HDPublicKeyID, err2 = decodeHDKeyID(pj.HDPublicKeyID); err2 != nil {
+		return fmt.Errorf("chaincfg: invalid hd_public_key_id: %w", err2)
+	}
+	if p.StakeBaseSigScript, err2 = hex.DecodeString(pj.StakeBaseSigScript); err2 != nil {
+		return fmt.Errorf("chaincfg: invalid stake_base_sig_script: %w", err2)
+	}
+	if p.OrganizationPkScript, err2 = hex.DecodeString(pj.OrganizationPkScript); err2 != nil {
+		return fmt.Errorf("chaincfg: invalid organization_pk_script: %w", err2)
+	}
+
+	return nil
+}
+
+func decodeAddrID(s string) (id [2]byte, err error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return id, err
+	}
+	if len(b) != len(id) {
+		return id, fmt.Errorf("expected %d bytes, got %d", len(id), len(b))
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+func decodeHDKeyID(s string) (id [4]byte, err error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return id, err
+	}
+	if len(b) != len(id) {
+		return id, fmt.Errorf("expected %d bytes, got %d", len(id), len(b))
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+// MarshalJSON implements json.Marshaler, emitting a deterministic,
+// cross-language representation of the network parameters.  Fixed-size
+// byte arrays and bitmask fields are emitted as hex strings, and every
+// field in the document, including the nested deployment/vote/choice
+// entries, is snake_case.
+func (p *Params) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.toParamsJSON())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.  It rejects unknown fields and
+// runs Validate on the resulting Params so that a malformed params file
+// fails fast instead of producing a Params with silently-zeroed fields.
+func (p *Params) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var pj paramsJSON
+	if err := dec.Decode(&pj); err != nil {
+		return err
+	}
+	if err := p.fromParamsJSON(&pj); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+// LoadParamsFromFile loads network parameters from the JSON file at path.
+// JSON is the only supported serialization: chaincfg is a consensus-critical
+// package, and pulling in an external TOML encoder/decoder just to offer a
+// second format was not worth the added dependency.  It is intended to give
+// non-Go tooling (block explorers, SPV clients, wallets written in other
+// languages) and integration test harnesses a single canonical network
+// definition file to share instead of each re-encoding the constants
+// independently.  The loaded Params is validated before being returned, so
+// a malformed file fails fast at startup.
+func LoadParamsFromFile(path string) (*Params, error) {
+	if ext := strings.ToLower(filepath.Ext(path)); ext != ".json" {
+		return nil, fmt.Errorf("chaincfg: unsupported params file extension %q", ext)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	params := new(Params)
+	if err := params.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("chaincfg: failed to load %s: %w", path, err)
+	}
+
+	return params, nil
+}
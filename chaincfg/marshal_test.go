@@ -0,0 +1,111 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// roundTripTestParams returns a copy of RegNetParams with GenesisBlock
+// cleared, mirroring what LoadParamsFromFile produces: GenesisBlock is
+// intentionally not part of the serialized format, so Validate must accept
+// a Params that only has GenesisHash set.
+func roundTripTestParams() *Params {
+	p := RegNetParams
+	p.GenesisBlock = nil
+	return &p
+}
+
+func TestParamsRoundTripJSON(t *testing.T) {
+	want := roundTripTestParams()
+
+	data, err := want.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	got := new(Params)
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("round trip mismatch:\nwant: %+v\ngot:  %+v", want, got)
+	}
+}
+
+// TestMarshalJSONDeploymentsAreSnakeCaseHex ensures the deployments subtree
+// doesn't regress into the CamelCase "casing island" a previous version of
+// paramsJSON shipped, and that Mask/Bits follow the same hex convention as
+// the rest of the document instead of being emitted as bare decimals.
+func TestMarshalJSONDeploymentsAreSnakeCaseHex(t *testing.T) {
+	want := roundTripTestParams()
+
+	data, err := want.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	doc := string(data)
+
+	for _, key := range []string{`"vote"`, `"start_time"`, `"expire_time"`, `"id"`, `"mask"`, `"choices"`, `"is_abstain"`, `"is_no"`} {
+		if !strings.Contains(doc, key) {
+			t.Errorf("expected snake_case key %s in marshaled deployments, not found", key)
+		}
+	}
+	for _, key := range []string{`"Vote"`, `"StartTime"`, `"ExpireTime"`, `"Mask"`, `"Choices"`, `"IsAbstain"`, `"IsNo"`} {
+		if strings.Contains(doc, key) {
+			t.Errorf("found CamelCase key %s in marshaled deployments", key)
+		}
+	}
+
+	for version, deployments := range want.Deployments {
+		for _, d := range deployments {
+			maskHex := `"mask":"` + voteToJSON(d.Vote).Mask + `"`
+			if !strings.Contains(doc, maskHex) {
+				t.Errorf("deployment version %d: expected hex mask %s in document", version, maskHex)
+			}
+		}
+	}
+}
+
+// TestParamsRoundTripPreservesBlake3PowFields specifically exercises the
+// fields added alongside the VoteIDBlake3Pow agenda, since a prior version
+// of paramsJSON silently dropped them.
+func TestParamsRoundTripPreservesBlake3PowFields(t *testing.T) {
+	want := roundTripTestParams()
+	want.Blake3PowActivationHeight = 12345
+	want.PowHashAlgorithm = PoWHashBlake3
+
+	data, err := want.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+
+	got := new(Params)
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+
+	if got.Blake3PowActivationHeight != want.Blake3PowActivationHeight {
+		t.Errorf("Blake3PowActivationHeight: got %d, want %d",
+			got.Blake3PowActivationHeight, want.Blake3PowActivationHeight)
+	}
+	if got.PowHashAlgorithm != want.PowHashAlgorithm {
+		t.Errorf("PowHashAlgorithm: got %v, want %v", got.PowHashAlgorithm, want.PowHashAlgorithm)
+	}
+}
+
+// TestUnmarshalJSONRejectsUnknownFields ensures a typo'd field name in a
+// params file is caught instead of silently ignored.
+func TestUnmarshalJSONRejectsUnknownFields(t *testing.T) {
+	data := []byte(`{"name": "badnet", "unknown_field": 1}`)
+
+	p := new(Params)
+	if err := p.UnmarshalJSON(data); err == nil {
+		t.Fatal("expected error decoding params with an unknown field")
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+// These are the vote IDs for the consensus deployments that have been
+// defined across the standard networks.
+const (
+	// VoteIDMaxBlockSize is the vote ID for the vote described by
+	// voteMaxBlockSize.
+	VoteIDMaxBlockSize = "maxblocksize"
+
+	// VoteIDSDiffAlgorithm is the vote ID for the vote described by
+	// voteSDiffAlgorithm.
+	VoteIDSDiffAlgorithm = "sdiffalgorithm"
+
+	// VoteIDLNFeatures is the vote ID for the vote described by
+	// voteLNFeatures.
+	VoteIDLNFeatures = "lnfeatures"
+)
+
+// Checkpoint identifies a known good point in the block chain.  Using
+// checkpoints allows a few optimizations for old blocks by avoiding
+// expensive signature verification and allows for faster synchronization
+// when it is unlikely that a serious chain reorganization could be done.
+type Checkpoint struct {
+	Height int64
+	Hash   *chainhash.Hash
+}
+
+// Vote describes a consensus rule change vote that is part of a
+// ConsensusDeployment along with all of its possible choices.
+type Vote struct {
+	Id          string
+	Description string
+	Mask        uint16
+	Choices     []Choice
+}
+
+// Choice identifies one of the possible outcomes for a consensus rule change
+// vote.
+type Choice struct {
+	Id          string
+	Description string
+	Bits        uint16
+	IsAbstain   bool
+	IsNo        bool
+}
+
+// ConsensusDeployment defines details related to a specific consensus rule
+// change deployment that is voted in.  This is similar to the BIP0009
+// mechanism with the difference being stake votes are used to support a
+// tunable activation threshold.  StartTime and ExpireTime are Unix times in
+// seconds bounding the voting window.
+type ConsensusDeployment struct {
+	Vote       Vote
+	StartTime  uint64
+	ExpireTime uint64
+}
+
+// TokenPayout defines an address and amount that is paid out as part of the
+// initial block one premine ledger for a network.
+type TokenPayout struct {
+	Address       string `json:"address"`
+	ScriptVersion uint16 `json:"script_version"`
+	Amount        int64  `json:"amount"`
+}
+
+// Params defines a Decred network by its parameters.  These parameters may
+// be used by Decred applications to differentiate networks as well as
+// addresses and keys for one network from those intended for use on another
+// network.
+type Params struct {
+	// Name defines a human-readable identifier for the network.
+	Name string
+
+	// Net defines the magic bytes used to identify the network.
+	Net wire.CurrencyNet
+
+	// DefaultPort defines the default peer-to-peer port for the network.
+	DefaultPort string
+
+	// DNSSeeds defines a list of DNS seeds for the network that are used
+	// as one method to discover peers.
+	DNSSeeds []string
+
+	// GenesisBlock defines the first block of the chain.
+	GenesisBlock *wire.MsgBlock
+
+	// GenesisHash is the starting block hash.
+	GenesisHash *chainhash.Hash
+
+	// PowLimit defines the highest allowed proof of work value for a
+	// block as a uint256.
+	PowLimit *big.Int
+
+	// PowLimitBits defines the highest allowed proof of work value for a
+	// block in compact form.
+	PowLimitBits uint32
+
+	// PowHashAlgorithm defines the proof-of-work hash function that is
+	// active prior to the VoteIDBlake3Pow agenda activating.
+	PowHashAlgorithm PowHashAlgorithm
+
+	// MinKnownChainWork is the minimum amount of known total work for the
+	// chain at a given point in time, used by light clients to bootstrap
+	// safely.
+	MinKnownChainWork *big.Int
+
+	// Blake3PowActivationHeight is the height at which the blake3 proof
+	// of work hash function becomes active once the VoteIDBlake3Pow
+	// agenda has been locked in.  A value of zero means the agenda has
+	// not activated on this network.
+	Blake3PowActivationHeight int64
+
+	// ReduceMinDifficulty defines whether the network should reduce the
+	// minimum required difficulty after a long enough period of time has
+	// passed without finding a block.
+	ReduceMinDifficulty bool
+
+	// MinDiffReductionTime defines the amount of time after which the
+	// minimum required difficulty should be reduced when a block hasn't
+	// been found.
+	MinDiffReductionTime time.Duration
+
+	// GenerateSupported defines whether CPU mining is allowed.
+	GenerateSupported bool
+
+	// MaximumBlockSizes are the maximum sizes of a block that can be
+	// generated over time for the network, ordered from oldest to
+	// newest.
+	MaximumBlockSizes []int
+
+	// MaxTxSize is the maximum number of bytes a serialized transaction
+	// can be in order to be considered valid.
+	MaxTxSize int
+
+	// TargetTimePerBlock is the desired amount of time to generate each
+	// block.
+	TargetTimePerBlock time.Duration
+
+	// WorkDiffAlpha is the work difficulty EMA calculation alpha (smoothing)
+	// value.
+	WorkDiffAlpha int64
+
+	// WorkDiffWindowSize is the number of blocks used for each difficulty
+	// window.
+	WorkDiffWindowSize int64
+
+	// WorkDiffWindows is the number of windows used for difficulty
+	// retargeting by work.
+	WorkDiffWindows int64
+
+	// TargetTimespan is the desired amount of time it should take to
+	// find enough blocks to generate the initial difficulty window.
+	TargetTimespan time.Duration
+
+	// RetargetAdjustmentFactor is the adjustment factor used to limit
+	// the minimum and maximum amount of adjustment that can occur
+	// between difficulty retargets.
+	RetargetAdjustmentFactor int64
+
+	// BaseSubsidy is the starting subsidy amount for mined blocks.
+	BaseSubsidy int64
+
+	// MulSubsidy is the multiplier for the subsidy reduction algorithm.
+	MulSubsidy int64
+
+	// DivSubsidy is the divisor for the subsidy reduction algorithm.
+	DivSubsidy int64
+
+	// SubsidyReductionInterval is the reduction interval in blocks.
+	SubsidyReductionInterval int64
+
+	// WorkRewardProportion, StakeRewardProportion, and BlockTaxProportion
+	// are the proportions of the total subsidy paid to proof-of-work
+	// miners, proof-of-stake voters, and the project treasury,
+	// respectively.  They must sum to totalSubsidyProportions.
+	WorkRewardProportion  uint16
+	StakeRewardProportion uint16
+	BlockTaxProportion    uint16
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints []Checkpoint
+
+	// RuleChangeActivationQuorum is the number of votes required to
+	// reach quorum for a consensus rule change deployment vote.
+	RuleChangeActivationQuorum uint32
+
+	// RuleChangeActivationMultiplier and RuleChangeActivationDivisor
+	// define the fraction of votes required to activate a consensus
+	// rule change deployment.
+	RuleChangeActivationMultiplier int64
+	RuleChangeActivationDivisor    int64
+
+	// RuleChangeActivationInterval is the number of blocks in a voting
+	// window for consensus rule change deployments.
+	RuleChangeActivationInterval uint32
+
+	// Deployments defines the specific consensus rule change deployments
+	// and their associated details, keyed by the deployment version.
+	Deployments map[uint32][]ConsensusDeployment
+
+	// BlockEnforceNumRequired, BlockRejectNumRequired, and
+	// BlockUpgradeNumToCheck define the parameters used when considering
+	// block version upgrades.
+	BlockEnforceNumRequired uint64
+	BlockRejectNumRequired  uint64
+	BlockUpgradeNumToCheck  uint64
+
+	// AcceptNonStdTxs is a mempool param to either accept and relay
+	// non-standard transactions to the network or reject them.
+	AcceptNonStdTxs bool
+
+	// NetworkAddressPrefix is the human-readable address prefix that
+	// identifies the network, such as "XCM" for the main network.
+	NetworkAddressPrefix string
+
+	// Address encoding magics.
+	PubKeyAddrID     [2]byte
+	PubKeyHashAddrID [2]byte
+	PKHEdwardsAddrID [2]byte
+	PKHSchnorrAddrID [2]byte
+	ScriptHashAddrID [2]byte
+	PrivateKeyID     [2]byte
+
+	// BIP32 hierarchical deterministic extended key magics.
+	HDPrivateKeyID [4]byte
+	HDPublicKeyID  [4]byte
+
+	// SLIP0044CoinType and LegacyCoinType are the BIP44 coin types used
+	// in the hierarchical deterministic path for address generation.
+	SLIP0044CoinType uint32
+	LegacyCoinType   uint32
+
+	// Decred proof-of-stake parameters.
+	MinimumStakeDiff        int64
+	TicketPoolSize          uint16
+	TicketsPerBlock         uint16
+	TicketMaturity          uint16
+	TicketExpiry            uint32
+	CoinbaseMaturity        uint16
+	SStxChangeMaturity      int64
+	TicketPoolSizeWeight    uint16
+	StakeDiffAlpha          int64
+	StakeDiffWindowSize     int64
+	StakeDiffWindows        int64
+	StakeVersionInterval    int64
+	MaxFreshStakePerBlock   uint32
+	StakeEnabledHeight      int64
+	StakeValidationHeight   int64
+	StakeBaseSigScript      []byte
+	StakeMajorityMultiplier int64
+	StakeMajorityDivisor    int64
+
+	// Decred organization related parameters.
+	OrganizationPkScript        []byte
+	OrganizationPkScriptVersion uint16
+	BlockOneLedger              []TokenPayout
+}
+
+// totalSubsidyProportions is the value that WorkRewardProportion,
+// StakeRewardProportion, and BlockTaxProportion must sum to; it matches the
+// fixed denominator the consensus subsidy-split calculation divides by.
+const totalSubsidyProportions = 10
+
+// hexDecode decodes the passed hex string and returns the resulting bytes.
+// It panics if an error occurs since it is only used with hard-coded,
+// and therefore known good, hex strings.
+func hexDecode(hexStr string) []byte {
+	b, err := hex.DecodeString(hexStr)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
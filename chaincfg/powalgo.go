@@ -0,0 +1,52 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+// VoteIDBlake3Pow is the vote ID for the vote described by
+// voteBlake3Pow.
+const VoteIDBlake3Pow = "blake3pow"
+
+// PowHashAlgorithm defines the proof-of-work hash function that applies to a
+// given block height.  It exists so that consensus code can switch hash
+// functions based on agenda activation instead of callers hard-coding a
+// single algorithm.
+type PowHashAlgorithm int
+
+const (
+	// PoWHashBlake256 identifies the blake256r14 proof-of-work hash
+	// function used since the genesis block.
+	PoWHashBlake256 PowHashAlgorithm = iota
+
+	// PoWHashBlake3 identifies the blake3 proof-of-work hash function
+	// introduced by the VoteIDBlake3Pow agenda (DCP0011).
+	PoWHashBlake3
+)
+
+// String returns the PowHashAlgorithm in human-readable form.
+func (a PowHashAlgorithm) String() string {
+	switch a {
+	case PoWHashBlake256:
+		return "blake256r14"
+	case PoWHashBlake3:
+		return "blake3"
+	default:
+		return "unknown"
+	}
+}
+
+// PowHashForHeight returns the proof-of-work hash algorithm that is active
+// for the provided block height.  Prior to the VoteIDBlake3Pow agenda
+// becoming active, the network's configured PowHashAlgorithm is returned.
+//
+// NOTE: This only consults the configured agenda activation height for the
+// blake3 vote and does not itself determine whether the agenda has been
+// locked in.  Callers are expected to set Blake3PowActivationHeight once the
+// agenda results are known, typically from the stake database.
+func (p *Params) PowHashForHeight(height int64) PowHashAlgorithm {
+	if p.Blake3PowActivationHeight > 0 && height >= p.Blake3PowActivationHeight {
+		return PoWHashBlake3
+	}
+	return p.PowHashAlgorithm
+}
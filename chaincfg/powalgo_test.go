@@ -0,0 +1,58 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import "testing"
+
+// TestPowHashForHeight ensures PowHashForHeight only switches to blake3 once
+// the activation height has been set and reached.
+func TestPowHashForHeight(t *testing.T) {
+	p := &Params{Blake3PowActivationHeight: 100}
+
+	tests := []struct {
+		height int64
+		want   PowHashAlgorithm
+	}{
+		{height: 0, want: PoWHashBlake256},
+		{height: 99, want: PoWHashBlake256},
+		{height: 100, want: PoWHashBlake3},
+		{height: 101, want: PoWHashBlake3},
+	}
+	for _, test := range tests {
+		got := p.PowHashForHeight(test.height)
+		if got != test.want {
+			t.Errorf("PowHashForHeight(%d): got %v, want %v", test.height, got, test.want)
+		}
+	}
+
+	unset := &Params{}
+	if got := unset.PowHashForHeight(1 << 30); got != PoWHashBlake256 {
+		t.Errorf("PowHashForHeight with unset activation height: got %v, want %v",
+			got, PoWHashBlake256)
+	}
+}
+
+// TestBlake3PowAgendaDefined ensures the VoteIDBlake3Pow agenda is defined
+// under deployment version 11 for every standard network.
+func TestBlake3PowAgendaDefined(t *testing.T) {
+	for _, net := range []*Params{&MainNetParams, &TestNet3Params, &SimNetParams, &RegNetParams} {
+		deployments, ok := net.Deployments[11]
+		if !ok {
+			t.Errorf("%s: no deployment version 11 defined", net.Name)
+			continue
+		}
+
+		found := false
+		for _, d := range deployments {
+			if d.Vote.Id == VoteIDBlake3Pow {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%s: VoteIDBlake3Pow not defined under deployment version 11", net.Name)
+		}
+	}
+}
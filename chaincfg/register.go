@@ -0,0 +1,270 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// These variables are the registry of networks known to this package, keyed
+// by each value that Register requires to be unique across networks.
+var (
+	registeredNets          = make(map[wire.CurrencyNet]*Params)
+	registeredNetsByName    = make(map[string]*Params)
+	registeredAddressPrefix = make(map[string]*Params)
+	registeredDefaultPorts  = make(map[string]*Params)
+	registeredNetsMtx       sync.RWMutex
+
+	pubKeyAddrIDs     = make(map[[2]byte]*Params)
+	pubKeyHashAddrIDs = make(map[[2]byte]*Params)
+	pkhEdwardsAddrIDs = make(map[[2]byte]*Params)
+	pkhSchnorrAddrIDs = make(map[[2]byte]*Params)
+	scriptHashAddrIDs = make(map[[2]byte]*Params)
+	privateKeyIDs     = make(map[[2]byte]*Params)
+
+	hdPrivateKeyIDs = make(map[[4]byte]*Params)
+	hdPublicKeyIDs  = make(map[[4]byte]*Params)
+
+	hdPrivToPubKeyIDs = make(map[[4]byte][]byte)
+)
+
+// ErrDuplicateNet describes an error where the parameters for a Decred
+// network could not be set due to it already being registered.
+var ErrDuplicateNet = fmt.Errorf("duplicate Decred network")
+
+// ErrDuplicateAddressMagic describes an error where the address magic for a
+// Decred network could not be set due to it already being registered to
+// another network.
+var ErrDuplicateAddressMagic = fmt.Errorf("duplicate address magic")
+
+// ErrDuplicateHDKeyID describes an error where the HD extended key magic for
+// a Decred network could not be set due to it already being registered to
+// another network.
+var ErrDuplicateHDKeyID = fmt.Errorf("duplicate HD extended key ID")
+
+// ErrDuplicateAddressPrefix describes an error where the human-readable
+// address prefix for a Decred network could not be set due to it already
+// being registered to another network.
+var ErrDuplicateAddressPrefix = fmt.Errorf("duplicate network address prefix")
+
+// ErrDuplicateDefaultPort describes an error where the default peer-to-peer
+// port for a Decred network could not be set due to it already being
+// registered to another network.
+var ErrDuplicateDefaultPort = fmt.Errorf("duplicate default port")
+
+// ErrUnknownNet describes an error where a network name or identifier does
+// not correspond to any network that has been registered.
+var ErrUnknownNet = fmt.Errorf("unknown Decred network")
+
+func init() {
+	// Register all default networks when the package is initialized so
+	// callers that only rely on the historical behavior of this package
+	// continue to work without calling Register themselves.
+	for _, params := range []*Params{
+		&MainNetParams,
+		&TestNet3Params,
+		&SimNetParams,
+		&RegNetParams,
+	} {
+		if err := Register(params); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Register registers the network parameters for a Decred network so that
+// the custom network may be recognized by dcrutil-style address decoders
+// and other code that resolves networks by name or magic.  It returns
+// ErrDuplicateNet if the network or its name is already registered,
+// ErrDuplicateAddressPrefix / ErrDuplicateDefaultPort if the network address
+// prefix or default port collide with an already registered network, or
+// ErrDuplicateAddressMagic / ErrDuplicateHDKeyID if any of the address or HD
+// extended key magic bytes collide with a network that is already
+// registered.
+//
+// Register is intended to be called at most once per network, typically
+// during package or application initialization.  Custom networks defined
+// outside of this package must call Register (or MustRegister) before the
+// network's parameters can be looked up via ParamsByNet or ParamsByName.
+func Register(params *Params) error {
+	registeredNetsMtx.Lock()
+	defer registeredNetsMtx.Unlock()
+
+	if _, ok := registeredNets[params.Net]; ok {
+		return ErrDuplicateNet
+	}
+	if _, ok := registeredNetsByName[params.Name]; ok {
+		return ErrDuplicateNet
+	}
+
+	if _, ok := registeredAddressPrefix[params.NetworkAddressPrefix]; ok {
+		return ErrDuplicateAddressPrefix
+	}
+	if _, ok := registeredDefaultPorts[params.DefaultPort]; ok {
+		return ErrDuplicateDefaultPort
+	}
+
+	if _, ok := pubKeyAddrIDs[params.PubKeyAddrID]; ok {
+		return ErrDuplicateAddressMagic
+	}
+	if _, ok := pubKeyHashAddrIDs[params.PubKeyHashAddrID]; ok {
+		return ErrDuplicateAddressMagic
+	}
+	if _, ok := pkhEdwardsAddrIDs[params.PKHEdwardsAddrID]; ok {
+		return ErrDuplicateAddressMagic
+	}
+	if _, ok := pkhSchnorrAddrIDs[params.PKHSchnorrAddrID]; ok {
+		return ErrDuplicateAddressMagic
+	}
+	if _, ok := scriptHashAddrIDs[params.ScriptHashAddrID]; ok {
+		return ErrDuplicateAddressMagic
+	}
+	if _, ok := privateKeyIDs[params.PrivateKeyID]; ok {
+		return ErrDuplicateAddressMagic
+	}
+
+	if _, ok := hdPrivateKeyIDs[params.HDPrivateKeyID]; ok {
+		return ErrDuplicateHDKeyID
+	}
+	if _, ok := hdPublicKeyIDs[params.HDPublicKeyID]; ok {
+		return ErrDuplicateHDKeyID
+	}
+
+	registeredNets[params.Net] = params
+	registeredNetsByName[params.Name] = params
+	registeredAddressPrefix[params.NetworkAddressPrefix] = params
+	registeredDefaultPorts[params.DefaultPort] = params
+
+	pubKeyAddrIDs[params.PubKeyAddrID] = params
+	pubKeyHashAddrIDs[params.PubKeyHashAddrID] = params
+	pkhEdwardsAddrIDs[params.PKHEdwardsAddrID] = params
+	pkhSchnorrAddrIDs[params.PKHSchnorrAddrID] = params
+	scriptHashAddrIDs[params.ScriptHashAddrID] = params
+	privateKeyIDs[params.PrivateKeyID] = params
+
+	hdPrivateKeyIDs[params.HDPrivateKeyID] = params
+	hdPublicKeyIDs[params.HDPublicKeyID] = params
+	hdPrivToPubKeyIDs[params.HDPrivateKeyID] = params.HDPublicKeyID[:]
+
+	return nil
+}
+
+// MustRegister performs the same function as Register except it panics if
+// the network is already registered or any of its magic bytes collide with
+// a previously registered network.  It is intended to be called from
+// package init functions that define custom Decred networks.
+func MustRegister(params *Params) {
+	if err := Register(params); err != nil {
+		panic("chaincfg: failed to register network: " + err.Error())
+	}
+}
+
+// Deregister removes the network with the provided name from the package's
+// registry, allowing a network with the same name or magic bytes to be
+// registered again.  It returns ErrUnknownNet if no such network is
+// currently registered.
+//
+// Deregister exists primarily to support tests that register a throwaway
+// custom network; production code should generally leave networks
+// registered for the lifetime of the process.
+func Deregister(name string) error {
+	registeredNetsMtx.Lock()
+	defer registeredNetsMtx.Unlock()
+
+	params, ok := registeredNetsByName[name]
+	if !ok {
+		return ErrUnknownNet
+	}
+
+	delete(registeredNets, params.Net)
+	delete(registeredNetsByName, params.Name)
+	delete(registeredAddressPrefix, params.NetworkAddressPrefix)
+	delete(registeredDefaultPorts, params.DefaultPort)
+
+	delete(pubKeyAddrIDs, params.PubKeyAddrID)
+	delete(pubKeyHashAddrIDs, params.PubKeyHashAddrID)
+	delete(pkhEdwardsAddrIDs, params.PKHEdwardsAddrID)
+	delete(pkhSchnorrAddrIDs, params.PKHSchnorrAddrID)
+	delete(scriptHashAddrIDs, params.ScriptHashAddrID)
+	delete(privateKeyIDs, params.PrivateKeyID)
+
+	delete(hdPrivateKeyIDs, params.HDPrivateKeyID)
+	delete(hdPublicKeyIDs, params.HDPublicKeyID)
+	delete(hdPrivToPubKeyIDs, params.HDPrivateKeyID)
+
+	return nil
+}
+
+// ParamsByNet returns the network parameters that were registered under the
+// provided wire.CurrencyNet and true, or false if no such network has been
+// registered.
+func ParamsByNet(net wire.CurrencyNet) (*Params, bool) {
+	registeredNetsMtx.RLock()
+	defer registeredNetsMtx.RUnlock()
+
+	params, ok := registeredNets[net]
+	return params, ok
+}
+
+// ParamsByName returns the network parameters that were registered under the
+// provided name and true, or false if no such network has been registered.
+func ParamsByName(name string) (*Params, bool) {
+	registeredNetsMtx.RLock()
+	defer registeredNetsMtx.RUnlock()
+
+	params, ok := registeredNetsByName[name]
+	return params, ok
+}
+
+// IsRegistered returns whether the network with the provided name has been
+// registered with this package.
+func IsRegistered(name string) bool {
+	registeredNetsMtx.RLock()
+	defer registeredNetsMtx.RUnlock()
+
+	_, ok := registeredNetsByName[name]
+	return ok
+}
+
+// ParamsByPubKeyHashAddrID returns the network parameters that registered
+// the provided pay-to-pubkey-hash address magic, and true, or false if no
+// registered network claims it.  This allows dcrutil-style address decoders
+// to resolve which network an address belongs to without the caller passing
+// params.
+func ParamsByPubKeyHashAddrID(id [2]byte) (*Params, bool) {
+	registeredNetsMtx.RLock()
+	defer registeredNetsMtx.RUnlock()
+
+	params, ok := pubKeyHashAddrIDs[id]
+	return params, ok
+}
+
+// ParamsByScriptHashAddrID returns the network parameters that registered
+// the provided pay-to-script-hash address magic, and true, or false if no
+// registered network claims it.
+func ParamsByScriptHashAddrID(id [2]byte) (*Params, bool) {
+	registeredNetsMtx.RLock()
+	defer registeredNetsMtx.RUnlock()
+
+	params, ok := scriptHashAddrIDs[id]
+	return params, ok
+}
+
+// IsPubKeyHashAddrID returns whether the provided address magic is a valid
+// pay-to-pubkey-hash magic for any registered network.
+func IsPubKeyHashAddrID(id [2]byte) bool {
+	_, ok := ParamsByPubKeyHashAddrID(id)
+	return ok
+}
+
+// IsScriptHashAddrID returns whether the provided address magic is a valid
+// pay-to-script-hash magic for any registered network.
+func IsScriptHashAddrID(id [2]byte) bool {
+	_, ok := ParamsByScriptHashAddrID(id)
+	return ok
+}
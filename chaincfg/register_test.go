@@ -0,0 +1,113 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/wire"
+)
+
+func testNetParams(name string, net wire.CurrencyNet) *Params {
+	return &Params{
+		Name:                 name,
+		Net:                  net,
+		DefaultPort:          "0",
+		NetworkAddressPrefix: name,
+		PubKeyAddrID:         [2]byte{0xff, 0x00},
+		PubKeyHashAddrID:     [2]byte{0xff, 0x01},
+		PKHEdwardsAddrID:     [2]byte{0xff, 0x02},
+		PKHSchnorrAddrID:     [2]byte{0xff, 0x03},
+		ScriptHashAddrID:     [2]byte{0xff, 0x04},
+		PrivateKeyID:         [2]byte{0xff, 0x05},
+		HDPrivateKeyID:       [4]byte{0xff, 0xff, 0xff, 0x00},
+		HDPublicKeyID:        [4]byte{0xff, 0xff, 0xff, 0x01},
+	}
+}
+
+func TestRegisterAndDeregister(t *testing.T) {
+	params := testNetParams("unittestnet", wire.CurrencyNet(0xdeadbeef))
+
+	if err := Register(params); err != nil {
+		t.Fatalf("unexpected error registering network: %v", err)
+	}
+	defer Deregister(params.Name)
+
+	if !IsRegistered(params.Name) {
+		t.Fatal("network was not registered")
+	}
+	if got, ok := ParamsByName(params.Name); !ok || got != params {
+		t.Fatal("ParamsByName did not return the registered params")
+	}
+	if got, ok := ParamsByNet(params.Net); !ok || got != params {
+		t.Fatal("ParamsByNet did not return the registered params")
+	}
+	if got, ok := ParamsByPubKeyHashAddrID(params.PubKeyHashAddrID); !ok || got != params {
+		t.Fatal("ParamsByPubKeyHashAddrID did not return the registered params")
+	}
+	if got, ok := ParamsByScriptHashAddrID(params.ScriptHashAddrID); !ok || got != params {
+		t.Fatal("ParamsByScriptHashAddrID did not return the registered params")
+	}
+
+	if err := Deregister(params.Name); err != nil {
+		t.Fatalf("unexpected error deregistering network: %v", err)
+	}
+	if IsRegistered(params.Name) {
+		t.Fatal("network still registered after Deregister")
+	}
+	if err := Deregister(params.Name); !errors.Is(err, ErrUnknownNet) {
+		t.Fatalf("Deregister of unknown network: got %v, want %v", err, ErrUnknownNet)
+	}
+}
+
+func TestRegisterDuplicateDetection(t *testing.T) {
+	base := testNetParams("duptestnet", wire.CurrencyNet(0xdeadbeee))
+	if err := Register(base); err != nil {
+		t.Fatalf("unexpected error registering base network: %v", err)
+	}
+	defer Deregister(base.Name)
+
+	tests := []struct {
+		name    string
+		mutate  func(*Params)
+		wantErr error
+	}{
+		{"duplicate net", func(p *Params) { p.Net = base.Net }, ErrDuplicateNet},
+		{"duplicate name", func(p *Params) { p.Name = base.Name }, ErrDuplicateNet},
+		{"duplicate prefix", func(p *Params) { p.NetworkAddressPrefix = base.NetworkAddressPrefix }, ErrDuplicateAddressPrefix},
+		{"duplicate port", func(p *Params) { p.DefaultPort = base.DefaultPort }, ErrDuplicateDefaultPort},
+		{"duplicate pubkey id", func(p *Params) { p.PubKeyAddrID = base.PubKeyAddrID }, ErrDuplicateAddressMagic},
+		{"duplicate pubkey hash id", func(p *Params) { p.PubKeyHashAddrID = base.PubKeyHashAddrID }, ErrDuplicateAddressMagic},
+		{"duplicate pkh edwards id", func(p *Params) { p.PKHEdwardsAddrID = base.PKHEdwardsAddrID }, ErrDuplicateAddressMagic},
+		{"duplicate pkh schnorr id", func(p *Params) { p.PKHSchnorrAddrID = base.PKHSchnorrAddrID }, ErrDuplicateAddressMagic},
+		{"duplicate script hash id", func(p *Params) { p.ScriptHashAddrID = base.ScriptHashAddrID }, ErrDuplicateAddressMagic},
+		{"duplicate private key id", func(p *Params) { p.PrivateKeyID = base.PrivateKeyID }, ErrDuplicateAddressMagic},
+		{"duplicate hd private key id", func(p *Params) { p.HDPrivateKeyID = base.HDPrivateKeyID }, ErrDuplicateHDKeyID},
+		{"duplicate hd public key id", func(p *Params) { p.HDPublicKeyID = base.HDPublicKeyID }, ErrDuplicateHDKeyID},
+	}
+
+	for i, test := range tests {
+		candidate := testNetParams("duptestnet2", wire.CurrencyNet(0xdeadbeed))
+		candidate.DefaultPort = "1"
+		candidate.PubKeyAddrID = [2]byte{0xfe, 0x00}
+		candidate.PubKeyHashAddrID = [2]byte{0xfe, 0x01}
+		candidate.PKHEdwardsAddrID = [2]byte{0xfe, 0x02}
+		candidate.PKHSchnorrAddrID = [2]byte{0xfe, 0x03}
+		candidate.ScriptHashAddrID = [2]byte{0xfe, 0x04}
+		candidate.PrivateKeyID = [2]byte{0xfe, 0x05}
+		candidate.HDPrivateKeyID = [4]byte{0xff, 0xff, 0xfe, 0x00}
+		candidate.HDPublicKeyID = [4]byte{0xff, 0xff, 0xfe, 0x01}
+		test.mutate(candidate)
+
+		err := Register(candidate)
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("test %d (%s): got error %v, want %v", i, test.name, err, test.wantErr)
+		}
+		if err == nil {
+			Deregister(candidate.Name)
+		}
+	}
+}
@@ -21,190 +21,34 @@ import (
 //
 // Since this network is only intended for unit testing, its values are subject
 // to change even if it would cause a hard fork.
-var RegNetParams = Params{
-	Name:        "regnet",
-	Net:         wire.RegNet,
-	DefaultPort: "10319",
-	DNSSeeds:    nil, // NOTE: There must NOT be any seeds.
-
-	// Chain parameters
-	GenesisBlock:             &regNetGenesisBlock,
-	GenesisHash:              &regNetGenesisHash,
-	PowLimit:                 regNetPowLimit,
-	PowLimitBits:             0x207fffff,
-	ReduceMinDifficulty:      false,
-	MinDiffReductionTime:     0, // Does not apply since ReduceMinDifficulty false
-	GenerateSupported:        true,
-	MaximumBlockSizes:        []int{1000000, 1310720},
-	MaxTxSize:                1000000,
-	TargetTimePerBlock:       time.Second,
-	WorkDiffAlpha:            1,
-	WorkDiffWindowSize:       8,
-	WorkDiffWindows:          4,
-	TargetTimespan:           time.Second * 8, // TimePerBlock * WindowSize
-	RetargetAdjustmentFactor: 4,
-
-	// Subsidy parameters.
-	BaseSubsidy:              50000000000,
-	MulSubsidy:               100,
-	DivSubsidy:               101,
-	SubsidyReductionInterval: 128,
-	WorkRewardProportion:     6,
-	StakeRewardProportion:    3,
-	BlockTaxProportion:       1,
-
-	// Checkpoints ordered from oldest to newest.
-	Checkpoints: nil,
-
-	// Consensus rule change deployments.
-	//
-	// The miner confirmation window is defined as:
-	//   target proof of work timespan / target proof of work spacing
-	RuleChangeActivationQuorum:     160, // 10 % of RuleChangeActivationInterval * TicketsPerBlock
-	RuleChangeActivationMultiplier: 3,   // 75%
-	RuleChangeActivationDivisor:    4,
-	RuleChangeActivationInterval:   320, // Full ticket pool -- 320 seconds
-	Deployments: map[uint32][]ConsensusDeployment{
-		4: {{
-			Vote: Vote{
-				Id:          VoteIDMaxBlockSize,
-				Description: "Change maximum allowed block size from 1MiB to 1.25MB",
-				Mask:        0x0006, // Bits 1 and 2
-				Choices: []Choice{{
-					Id:          "abstain",
-					Description: "abstain voting for change",
-					Bits:        0x0000,
-					IsAbstain:   true,
-					IsNo:        false,
-				}, {
-					Id:          "no",
-					Description: "reject changing max allowed block size",
-					Bits:        0x0002, // Bit 1
-					IsAbstain:   false,
-					IsNo:        true,
-				}, {
-					Id:          "yes",
-					Description: "accept changing max allowed block size",
-					Bits:        0x0004, // Bit 2
-					IsAbstain:   false,
-					IsNo:        false,
-				}},
-			},
-			StartTime:  0,             // Always available for vote
-			ExpireTime: math.MaxInt64, // Never expires
-		}},
-		5: {{
-			Vote: Vote{
-				Id:          VoteIDSDiffAlgorithm,
-				Description: "Change stake difficulty algorithm as defined in DCP0001",
-				Mask:        0x0006, // Bits 1 and 2
-				Choices: []Choice{{
-					Id:          "abstain",
-					Description: "abstain voting for change",
-					Bits:        0x0000,
-					IsAbstain:   true,
-					IsNo:        false,
-				}, {
-					Id:          "no",
-					Description: "keep the existing algorithm",
-					Bits:        0x0002, // Bit 1
-					IsAbstain:   false,
-					IsNo:        true,
-				}, {
-					Id:          "yes",
-					Description: "change to the new algorithm",
-					Bits:        0x0004, // Bit 2
-					IsAbstain:   false,
-					IsNo:        false,
-				}},
-			},
-			StartTime:  0,             // Always available for vote
-			ExpireTime: math.MaxInt64, // Never expires
-		}},
-		6: {{
-			Vote: Vote{
-				Id:          VoteIDLNFeatures,
-				Description: "Enable features defined in DCP0002 and DCP0003 necessary to support Lightning Network (LN)",
-				Mask:        0x0006, // Bits 1 and 2
-				Choices: []Choice{{
-					Id:          "abstain",
-					Description: "abstain voting for change",
-					Bits:        0x0000,
-					IsAbstain:   true,
-					IsNo:        false,
-				}, {
-					Id:          "no",
-					Description: "keep the existing consensus rules",
-					Bits:        0x0002, // Bit 1
-					IsAbstain:   false,
-					IsNo:        true,
-				}, {
-					Id:          "yes",
-					Description: "change to the new consensus rules",
-					Bits:        0x0004, // Bit 2
-					IsAbstain:   false,
-					IsNo:        false,
-				}},
-			},
-			StartTime:  0,             // Always available for vote
-			ExpireTime: math.MaxInt64, // Never expires
-		}},
-	},
-
-	// Enforce current block version once majority of the network has
-	// upgraded.
-	// 51% (51 / 100)
-	// Reject previous block versions once a majority of the network has
-	// upgraded.
-	// 75% (75 / 100)
-	BlockEnforceNumRequired: 51,
-	BlockRejectNumRequired:  75,
-	BlockUpgradeNumToCheck:  100,
-
-	// AcceptNonStdTxs is a mempool param to either accept and relay
-	// non standard txs to the network or reject them
-	AcceptNonStdTxs: true,
-
-	// Address encoding magics
-	NetworkAddressPrefix: "XCC",
-	PubKeyAddrID:         [2]byte{0x25, 0xe5}, // starts with Rk
-	PubKeyHashAddrID:     [2]byte{0x0e, 0x00}, // starts with Rs
-	PKHEdwardsAddrID:     [2]byte{0x0d, 0xe0}, // starts with Re
-	PKHSchnorrAddrID:     [2]byte{0x0d, 0xc2}, // starts with RS
-	ScriptHashAddrID:     [2]byte{0x0d, 0xdb}, // starts with Rc
-	PrivateKeyID:         [2]byte{0x22, 0xfe}, // starts with Pr
-
-	// BIP32 hierarchical deterministic extended key magics
-	HDPrivateKeyID: [4]byte{0xea, 0xb4, 0x04, 0x48}, // starts with rprv
-	HDPublicKeyID:  [4]byte{0xea, 0xb4, 0xf9, 0x87}, // starts with rpub
-
-	// BIP44 coin type used in the hierarchical deterministic path for
-	// address generation.
-	SLIP0044CoinType: 1, // SLIP0044, Testnet (all coins)
-	LegacyCoinType:   1,
-
-	// Decred PoS parameters
-	MinimumStakeDiff:        20000,
-	TicketPoolSize:          64,
-	TicketsPerBlock:         5,
-	TicketMaturity:          16,
-	TicketExpiry:            384, // 6*TicketPoolSize
-	CoinbaseMaturity:        16,
-	SStxChangeMaturity:      1,
-	TicketPoolSizeWeight:    4,
-	StakeDiffAlpha:          1,
-	StakeDiffWindowSize:     8,
-	StakeDiffWindows:        8,
-	StakeVersionInterval:    8 * 2 * 7,
-	MaxFreshStakePerBlock:   20,            // 4*TicketsPerBlock
-	StakeEnabledHeight:      16 + 16,       // CoinbaseMaturity + TicketMaturity
-	StakeValidationHeight:   16 + (64 * 2), // CoinbaseMaturity + TicketPoolSize*2
-	StakeBaseSigScript:      []byte{0x73, 0x57},
-	StakeMajorityMultiplier: 3,
-	StakeMajorityDivisor:    4,
-
-	// Decred organization related parameters
-	//
+//
+// It is assembled with NewParams and the With* options (rather than a single
+// struct literal like the other networks) to serve as the reference example
+// for defining a network that way.
+var RegNetParams = *NewParams("regnet", wire.RegNet,
+	WithSubsidyParams(50000000000, 100, 101, 128, 6, 3, 1),
+	WithStakeParams(64, 5, 16, 384, 16, 1, 16+(64*2)), // StakeValidationHeight = CoinbaseMaturity + TicketPoolSize*2
+	WithAddressMagics(
+		"XCC",
+		[2]byte{0x25, 0xe5}, // starts with Rk
+		[2]byte{0x0e, 0x00}, // starts with Rs
+		[2]byte{0x0d, 0xe0}, // starts with Re
+		[2]byte{0x0d, 0xc2}, // starts with RS
+		[2]byte{0x0d, 0xdb}, // starts with Rc
+		[2]byte{0x22, 0xfe}, // starts with Pr
+	),
+	WithHDKeyIDs(
+		[4]byte{0xea, 0xb4, 0x04, 0x48}, // starts with rprv
+		[4]byte{0xea, 0xb4, 0xf9, 0x87}, // starts with rpub
+		1,                               // SLIP0044, Testnet (all coins)
+		1,
+	),
+	WithDeployments(map[uint32][]ConsensusDeployment{
+		4:  deploymentAt(voteMaxBlockSize(), 0, math.MaxInt64),  // Always available, never expires
+		5:  deploymentAt(voteSDiffAlgorithm(), 0, math.MaxInt64), // Always available, never expires
+		6:  deploymentAt(voteLNFeatures(), 0, math.MaxInt64),    // Always available, never expires
+		11: deploymentAt(voteBlake3Pow(), 0, math.MaxInt64),    // Always available, never expires
+	}),
 	// Treasury address is a 3-of-3 P2SH going to a wallet with seed:
 	// aardvark adroitness aardvark adroitness
 	// aardvark adroitness aardvark adroitness
@@ -232,7 +76,70 @@ var RegNetParams = Params{
 	//   Rk8JV484ePPX6vWZCfBX2Scme5XriXhzwmyaKSYQT64HTbkkfyzL3
 	//
 	// Organization address is RcQR65gasxuzf7mUeBXeAux6Z37joPuUwUN
-	OrganizationPkScript:        hexDecode("a9146913bcc838bd0087fb3f6b3c868423d5e300078d87"),
-	OrganizationPkScriptVersion: 0,
-	BlockOneLedger:              BlockOneLedgerRegNet,
-}
+	WithTreasury(hexDecode("a9146913bcc838bd0087fb3f6b3c868423d5e300078d87"), 0, BlockOneLedgerRegNet),
+	func(p *Params) {
+		p.DefaultPort = "10319"
+		p.DNSSeeds = nil // NOTE: There must NOT be any seeds.
+
+		// Chain parameters
+		p.GenesisBlock = &regNetGenesisBlock
+		p.GenesisHash = &regNetGenesisHash
+		p.PowLimit = regNetPowLimit
+		p.PowLimitBits = 0x207fffff
+		p.ReduceMinDifficulty = false
+		p.MinDiffReductionTime = 0 // Does not apply since ReduceMinDifficulty false
+		p.GenerateSupported = true
+		p.MaximumBlockSizes = []int{1000000, 1310720}
+		p.MaxTxSize = 1000000
+		p.TargetTimePerBlock = time.Second
+		p.WorkDiffAlpha = 1
+		p.WorkDiffWindowSize = 8
+		p.WorkDiffWindows = 4
+		p.TargetTimespan = time.Second * 8 // TimePerBlock * WindowSize
+		p.RetargetAdjustmentFactor = 4
+		p.PowHashAlgorithm = PoWHashBlake256
+
+		// Checkpoints ordered from oldest to newest.
+		p.Checkpoints = nil
+
+		// MinKnownChainWork is the minimum amount of known total work for
+		// the chain at a given point in time.  Since regnet is only used
+		// for unit tests, it is intentionally left unset so genesis-only
+		// chains remain valid.
+		p.MinKnownChainWork = nil
+
+		// Consensus rule change deployments.
+		//
+		// The miner confirmation window is defined as:
+		//   target proof of work timespan / target proof of work spacing
+		p.RuleChangeActivationQuorum = 160 // 10 % of RuleChangeActivationInterval * TicketsPerBlock
+		p.RuleChangeActivationMultiplier = 3
+		p.RuleChangeActivationDivisor = 4
+		p.RuleChangeActivationInterval = 320 // Full ticket pool -- 320 seconds
+
+		// Enforce current block version once majority of the network has
+		// upgraded.
+		// 51% (51 / 100)
+		// Reject previous block versions once a majority of the network
+		// has upgraded.
+		// 75% (75 / 100)
+		p.BlockEnforceNumRequired = 51
+		p.BlockRejectNumRequired = 75
+		p.BlockUpgradeNumToCheck = 100
+
+		// AcceptNonStdTxs is a mempool param to either accept and relay
+		// non standard txs to the network or reject them
+		p.AcceptNonStdTxs = true
+
+		// Decred PoS parameters not covered by WithStakeParams.
+		p.MinimumStakeDiff = 20000
+		p.TicketPoolSizeWeight = 4
+		p.StakeDiffAlpha = 1
+		p.StakeDiffWindowSize = 8
+		p.StakeDiffWindows = 8
+		p.StakeVersionInterval = 8 * 2 * 7
+		p.StakeBaseSigScript = []byte{0x73, 0x57}
+		p.StakeMajorityMultiplier = 3
+		p.StakeMajorityDivisor = 4
+	},
+)
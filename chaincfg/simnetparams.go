@@ -0,0 +1,120 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"math"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// SimNetParams defines the network parameters for the simulation test
+// Decred network.  This network is similar to the normal test network
+// except it is intended for private use within a group of individuals doing
+// simulation testing and full integration tests between different
+// applications such as wallets, voting service providers, mining pools,
+// block explorers, and other services that build on Decred.
+var SimNetParams = Params{
+	Name:        "simnet",
+	Net:         wire.SimNet,
+	DefaultPort: "18555",
+	DNSSeeds:    nil, // NOTE: There must NOT be any seeds.
+
+	// Chain parameters
+	GenesisBlock:             &simNetGenesisBlock,
+	GenesisHash:              &simNetGenesisHash,
+	PowLimit:                 simNetPowLimit,
+	PowLimitBits:             0x207fffff,
+	PowHashAlgorithm:         PoWHashBlake256,
+	ReduceMinDifficulty:      false,
+	MinDiffReductionTime:     0,
+	GenerateSupported:        true,
+	MaximumBlockSizes:        []int{1000000, 1310720},
+	MaxTxSize:                1000000,
+	TargetTimePerBlock:       time.Second,
+	WorkDiffAlpha:            1,
+	WorkDiffWindowSize:       8,
+	WorkDiffWindows:          4,
+	TargetTimespan:           time.Second * 8, // TimePerBlock * WindowSize
+	RetargetAdjustmentFactor: 4,
+
+	// Subsidy parameters.
+	BaseSubsidy:              50000000000,
+	MulSubsidy:               100,
+	DivSubsidy:               101,
+	SubsidyReductionInterval: 128,
+	WorkRewardProportion:     6,
+	StakeRewardProportion:    3,
+	BlockTaxProportion:       1,
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints: nil,
+
+	// MinKnownChainWork is intentionally left unset since simnet chains
+	// are ephemeral and rebuilt from genesis for every simulation.
+	MinKnownChainWork: nil,
+
+	// Consensus rule change deployments.
+	RuleChangeActivationQuorum:     160, // 10 % of RuleChangeActivationInterval * TicketsPerBlock
+	RuleChangeActivationMultiplier: 3,   // 75%
+	RuleChangeActivationDivisor:    4,
+	RuleChangeActivationInterval:   320, // Full ticket pool -- 320 seconds
+	Deployments: map[uint32][]ConsensusDeployment{
+		4:  deploymentAt(voteMaxBlockSize(), 0, math.MaxInt64),  // Always available, never expires
+		5:  deploymentAt(voteSDiffAlgorithm(), 0, math.MaxInt64), // Always available, never expires
+		6:  deploymentAt(voteLNFeatures(), 0, math.MaxInt64),    // Always available, never expires
+		11: deploymentAt(voteBlake3Pow(), 0, math.MaxInt64),    // Always available, never expires
+	},
+
+	BlockEnforceNumRequired: 51,
+	BlockRejectNumRequired:  75,
+	BlockUpgradeNumToCheck:  100,
+
+	AcceptNonStdTxs: true,
+
+	// Address encoding magics
+	NetworkAddressPrefix: "XCS",
+	PubKeyAddrID:         [2]byte{0x27, 0x6f}, // starts with Sk
+	PubKeyHashAddrID:     [2]byte{0x0e, 0x91}, // starts with Ss
+	PKHEdwardsAddrID:     [2]byte{0x0e, 0x71}, // starts with Se
+	PKHSchnorrAddrID:     [2]byte{0x0e, 0x53}, // starts with SS
+	ScriptHashAddrID:     [2]byte{0x0e, 0x6c}, // starts with Sc
+	PrivateKeyID:         [2]byte{0x23, 0x07}, // starts with Ps
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x04, 0x20, 0xb9, 0x03}, // starts with sprv
+	HDPublicKeyID:  [4]byte{0x04, 0x20, 0xbd, 0x3d}, // starts with spub
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation.
+	SLIP0044CoinType: 1, // SLIP0044, Testnet (all coins)
+	LegacyCoinType:   115,
+
+	// Decred PoS parameters
+	MinimumStakeDiff:        20000,
+	TicketPoolSize:          64,
+	TicketsPerBlock:         5,
+	TicketMaturity:          16,
+	TicketExpiry:            384, // 6*TicketPoolSize
+	CoinbaseMaturity:        16,
+	SStxChangeMaturity:      1,
+	TicketPoolSizeWeight:    4,
+	StakeDiffAlpha:          1,
+	StakeDiffWindowSize:     8,
+	StakeDiffWindows:        8,
+	StakeVersionInterval:    8 * 2 * 7,
+	MaxFreshStakePerBlock:   20,            // 4*TicketsPerBlock
+	StakeEnabledHeight:      16 + 16,       // CoinbaseMaturity + TicketMaturity
+	StakeValidationHeight:   16 + (64 * 2), // CoinbaseMaturity + TicketPoolSize*2
+	StakeBaseSigScript:      []byte{0x73, 0x57},
+	StakeMajorityMultiplier: 3,
+	StakeMajorityDivisor:    4,
+
+	// Decred organization related parameters.
+	OrganizationPkScript:        hexDecode("a9146913bcc838bd0087fb3f6b3c868423d5e300078d87"),
+	OrganizationPkScriptVersion: 0,
+	BlockOneLedger:              BlockOneLedgerSimNet,
+}
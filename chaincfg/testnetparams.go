@@ -0,0 +1,117 @@
+// Copyright (c) 2023 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"math"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// TestNet3Params defines the network parameters for the public test Decred
+// network.
+var TestNet3Params = Params{
+	Name:        "testnet3",
+	Net:         wire.TestNet3,
+	DefaultPort: "19108",
+	DNSSeeds: []string{
+		"testnet-seed.constella.network",
+	},
+
+	// Chain parameters
+	GenesisBlock:             &testNet3GenesisBlock,
+	GenesisHash:              &testNet3GenesisHash,
+	PowLimit:                 testNet3PowLimit,
+	PowLimitBits:             0x1e00ffff,
+	PowHashAlgorithm:         PoWHashBlake256,
+	ReduceMinDifficulty:      true,
+	MinDiffReductionTime:     time.Minute * 10, // ~5 blocks
+	GenerateSupported:        true,
+	MaximumBlockSizes:        []int{1310720},
+	MaxTxSize:                1000000,
+	TargetTimePerBlock:       time.Minute * 2,
+	WorkDiffAlpha:            1,
+	WorkDiffWindowSize:       144,
+	WorkDiffWindows:          20,
+	TargetTimespan:           time.Minute * 2 * 144, // TimePerBlock * WindowSize
+	RetargetAdjustmentFactor: 4,
+
+	// Subsidy parameters.
+	BaseSubsidy:              2500000000,
+	MulSubsidy:               100,
+	DivSubsidy:               101,
+	SubsidyReductionInterval: 2048,
+	WorkRewardProportion:     6,
+	StakeRewardProportion:    3,
+	BlockTaxProportion:       1,
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints: nil,
+
+	// MinKnownChainWork is intentionally left unset for the test network.
+	MinKnownChainWork: nil,
+
+	// Consensus rule change deployments.
+	RuleChangeActivationQuorum:     2880, // 10 % of RuleChangeActivationInterval * TicketsPerBlock
+	RuleChangeActivationMultiplier: 3,    // 75%
+	RuleChangeActivationDivisor:    4,
+	RuleChangeActivationInterval:   5760, // 8 days
+	Deployments: map[uint32][]ConsensusDeployment{
+		4:  deploymentAt(voteMaxBlockSize(), 0, math.MaxInt64),  // Always available, never expires
+		5:  deploymentAt(voteSDiffAlgorithm(), 0, math.MaxInt64), // Always available, never expires
+		6:  deploymentAt(voteLNFeatures(), 0, math.MaxInt64),    // Always available, never expires
+		11: deploymentAt(voteBlake3Pow(), 0, math.MaxInt64),    // Always available, never expires
+	},
+
+	BlockEnforceNumRequired: 51,
+	BlockRejectNumRequired:  75,
+	BlockUpgradeNumToCheck:  100,
+
+	AcceptNonStdTxs: true,
+
+	// Address encoding magics
+	NetworkAddressPrefix: "XCT",
+	PubKeyAddrID:         [2]byte{0x28, 0xf7}, // starts with Tk
+	PubKeyHashAddrID:     [2]byte{0x0f, 0x21}, // starts with Ts
+	PKHEdwardsAddrID:     [2]byte{0x0f, 0x01}, // starts with Te
+	PKHSchnorrAddrID:     [2]byte{0x0e, 0xe3}, // starts with TS
+	ScriptHashAddrID:     [2]byte{0x0e, 0xfc}, // starts with Tc
+	PrivateKeyID:         [2]byte{0x23, 0x0e}, // starts with Pt
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x97}, // starts with tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xd1}, // starts with tpub
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation.
+	SLIP0044CoinType: 1, // SLIP0044, Testnet (all coins)
+	LegacyCoinType:   11,
+
+	// Decred PoS parameters
+	MinimumStakeDiff:        20000000,
+	TicketPoolSize:          1024,
+	TicketsPerBlock:         5,
+	TicketMaturity:          16,
+	TicketExpiry:            6144, // 6*TicketPoolSize
+	CoinbaseMaturity:        16,
+	SStxChangeMaturity:      1,
+	TicketPoolSizeWeight:    4,
+	StakeDiffAlpha:          1,
+	StakeDiffWindowSize:     144,
+	StakeDiffWindows:        20,
+	StakeVersionInterval:    144 * 2 * 7,
+	MaxFreshStakePerBlock:   20,        // 4*TicketsPerBlock
+	StakeEnabledHeight:      16 + 16,   // CoinbaseMaturity + TicketMaturity
+	StakeValidationHeight:   768,
+	StakeBaseSigScript:      []byte{0x00, 0x00},
+	StakeMajorityMultiplier: 3,
+	StakeMajorityDivisor:    4,
+
+	// Decred organization related parameters.
+	OrganizationPkScript:        hexDecode("a9146913bcc838bd0087fb3f6b3c868423d5e300078d87"),
+	OrganizationPkScriptVersion: 0,
+	BlockOneLedger:              BlockOneLedgerTestNet3,
+}